@@ -0,0 +1,180 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package adminaudit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// Event represents an admin audit event: a change made by an administrator
+// in Control Hub or through the API
+type Event struct {
+	ID         string                  `json:"id,omitempty"`
+	ActorOrgID string                  `json:"actorOrgId,omitempty"`
+	ActorID    string                  `json:"actorId,omitempty"`
+	Created    time.Time               `json:"created,omitempty"`
+	Data       EventData               `json:"data,omitempty"`
+	Errors     webexsdk.ResourceErrors `json:"errors,omitempty"`
+}
+
+// EventData describes the change recorded by an admin audit event
+type EventData struct {
+	ActorOrgName     string   `json:"actorOrgName,omitempty"`
+	ActorName        string   `json:"actorName,omitempty"`
+	ActorEmail       string   `json:"actorEmail,omitempty"`
+	ActorUserAgent   string   `json:"actorUserAgent,omitempty"`
+	ActorIP          string   `json:"actorIp,omitempty"`
+	AdminRoles       []string `json:"adminRoles,omitempty"`
+	TargetID         string   `json:"targetId,omitempty"`
+	TargetName       string   `json:"targetName,omitempty"`
+	TargetType       string   `json:"targetType,omitempty"`
+	TargetOrgID      string   `json:"targetOrgId,omitempty"`
+	TargetOrgName    string   `json:"targetOrgName,omitempty"`
+	EventCategory    string   `json:"eventCategory,omitempty"`
+	EventDescription string   `json:"eventDescription,omitempty"`
+	ActionText       string   `json:"actionText,omitempty"`
+	TrackingID       string   `json:"trackingId,omitempty"`
+}
+
+// ListOptions contains the options for listing admin audit events
+type ListOptions struct {
+	OrgID           string   `url:"orgId"`                     // Required
+	From            string   `url:"from"`                      // Required, e.g. FormatTime(t)
+	To              string   `url:"to"`                        // Required, e.g. FormatTime(t)
+	ActorID         string   `url:"actorId,omitempty"`         // Only events by this admin
+	EventCategories []string `url:"eventCategories,omitempty"` // Only events in these categories
+	Max             int      `url:"max,omitempty"`
+}
+
+// EventsPage represents a paginated list of admin audit events
+type EventsPage = webexsdk.TypedPage[Event]
+
+// FormatTime formats t for ListOptions.From and ListOptions.To
+func FormatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// Config holds the configuration for the AdminAudit plugin
+type Config struct {
+	// Any configuration settings for the adminaudit plugin can go here
+}
+
+// DefaultConfig returns the default configuration for the AdminAudit plugin
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Client is the admin audit events API client
+type Client struct {
+	webexClient *webexsdk.Client
+	config      *Config
+}
+
+// New creates a new AdminAudit plugin
+func New(webexClient *webexsdk.Client, config *Config) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &Client{
+		webexClient: webexClient,
+		config:      config,
+	}
+}
+
+// List returns a list of admin audit events of an organization between
+// From and To
+func (c *Client) List(options *ListOptions) (*EventsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*EventsPage, error) {
+	if options == nil || options.OrgID == "" {
+		return nil, fmt.Errorf("orgId is required")
+	}
+	if options.From == "" || options.To == "" {
+		return nil, fmt.Errorf("from and to are required")
+	}
+
+	params := url.Values{}
+	params.Set("orgId", options.OrgID)
+	params.Set("from", options.From)
+	params.Set("to", options.To)
+	if options.ActorID != "" {
+		params.Set("actorId", options.ActorID)
+	}
+	if len(options.EventCategories) > 0 {
+		params.Set("eventCategories", strings.Join(options.EventCategories, ","))
+	}
+	if options.Max > 0 {
+		params.Set("max", fmt.Sprintf("%d", options.Max))
+	}
+
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "adminAudit/events", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := webexsdk.NewPage(resp, c.webexClient, webexsdk.ResourceAdminAudit)
+	if err != nil {
+		return nil, err
+	}
+
+	return webexsdk.NewTypedPage[Event](page)
+}
+
+// ListAll returns an Iterator over every Event matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Event] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Event, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.Page, nil
+	})
+}
+
+// All returns a range-over-func sequence of every Event matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Event, error] {
+	return c.ListAll(ctx, options).All()
+}
+
+// ListEventCategories returns the categories admin audit events can be
+// filtered by, e.g. "USERS" or "LICENSES"
+func (c *Client) ListEventCategories() ([]string, error) {
+	return c.ListEventCategoriesCtx(context.Background())
+}
+
+// ListEventCategoriesCtx is like ListEventCategories but uses ctx for the
+// request.
+func (c *Client) ListEventCategoriesCtx(ctx context.Context) ([]string, error) {
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "adminAudit/eventCategories", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		EventCategories []string `json:"eventCategories"`
+	}
+	if err := webexsdk.ParseResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.EventCategories, nil
+}
@@ -0,0 +1,76 @@
+//go:build functional
+
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package adminaudit
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func functionalClient(t *testing.T) *webexsdk.Client {
+	t.Helper()
+	token := os.Getenv("WEBEX_ACCESS_TOKEN")
+	if token == "" {
+		t.Fatal("WEBEX_ACCESS_TOKEN environment variable is required")
+	}
+	client, err := webexsdk.NewClient(token, &webexsdk.Config{
+		BaseURL: "https://webexapis.com/v1",
+		Timeout: 30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create Webex client: %v", err)
+	}
+	return client
+}
+
+// TestFunctionalAdminAuditPoll lists the event categories and polls the
+// last day of admin audit events. It requires an admin token with the
+// spark-admin:audit_events_read scope.
+// Run with:
+//
+//	WEBEX_ACCESS_TOKEN=<admin-token> WEBEX_ORG_ID=<org-id> go test -tags functional -run TestFunctionalAdminAuditPoll -v ./adminaudit/
+func TestFunctionalAdminAuditPoll(t *testing.T) {
+	orgID := os.Getenv("WEBEX_ORG_ID")
+	if orgID == "" {
+		t.Skip("WEBEX_ORG_ID environment variable is required")
+	}
+	auditClient := New(functionalClient(t), nil)
+
+	categories, err := auditClient.ListEventCategories()
+	if webexsdk.IsForbidden(err) {
+		t.Skipf("Token lacks admin audit scopes: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("ListEventCategories failed: %v", err)
+	}
+	t.Logf("Event categories: %v", categories)
+
+	poller, err := auditClient.NewPoller(&PollerConfig{OrgID: orgID, Start: time.Now().Add(-24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("NewPoller failed: %v", err)
+	}
+	seen := make(map[string]bool)
+	err = poller.Poll(context.Background(), func(ctx context.Context, events []Event) error {
+		for _, event := range events {
+			if seen[event.ID] {
+				t.Errorf("Event %s delivered twice", event.ID)
+			}
+			seen[event.ID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	t.Logf("Polled %d events", len(seen))
+}
@@ -0,0 +1,121 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package adminaudit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	baseURL, _ := url.Parse(server.URL)
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.BaseURL = baseURL
+
+	return New(client, nil), server
+}
+
+func TestList(t *testing.T) {
+	auditPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/adminAudit/events" {
+			t.Errorf("Expected path '/adminAudit/events', got '%s'", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("orgId") != "org-1" {
+			t.Errorf("Expected orgId 'org-1', got '%s'", q.Get("orgId"))
+		}
+		if q.Get("from") != "2026-01-01T00:00:00.000Z" || q.Get("to") != "2026-01-02T00:00:00.000Z" {
+			t.Errorf("Unexpected window: %s - %s", q.Get("from"), q.Get("to"))
+		}
+		if q.Get("eventCategories") != "USERS,LICENSES" {
+			t.Errorf("Expected eventCategories 'USERS,LICENSES', got '%s'", q.Get("eventCategories"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{
+			"id": "event-1",
+			"actorOrgId": "org-1",
+			"actorId": "admin-1",
+			"created": "2026-01-01T10:00:00.000Z",
+			"data": {
+				"actorName": "Admin",
+				"actorEmail": "admin@example.com",
+				"adminRoles": ["Full_Admin"],
+				"targetId": "person-1",
+				"targetType": "User",
+				"eventCategory": "USERS",
+				"eventDescription": "A user was created",
+				"actionText": "Admin created user alice@example.com",
+				"trackingId": "ATLAS_1"
+			}
+		}]}`))
+	})
+	defer server.Close()
+
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	page, err := auditPlugin.List(&ListOptions{
+		OrgID:           "org-1",
+		From:            FormatTime(day),
+		To:              FormatTime(day.Add(24 * time.Hour)),
+		EventCategories: []string{"USERS", "LICENSES"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to list events: %v", err)
+	}
+	if len(page.Items) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(page.Items))
+	}
+	event := page.Items[0]
+	if event.Data.EventCategory != "USERS" || event.Data.ActorEmail != "admin@example.com" || len(event.Data.AdminRoles) != 1 {
+		t.Errorf("Unexpected event data: %+v", event.Data)
+	}
+	if !event.Created.Equal(day.Add(10 * time.Hour)) {
+		t.Errorf("Unexpected created time: %v", event.Created)
+	}
+
+	if _, err := auditPlugin.List(&ListOptions{From: "a", To: "b"}); err == nil {
+		t.Error("Expected error without orgId")
+	}
+	if _, err := auditPlugin.List(&ListOptions{OrgID: "org-1"}); err == nil {
+		t.Error("Expected error without from and to")
+	}
+}
+
+func TestListEventCategories(t *testing.T) {
+	auditPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/adminAudit/eventCategories" {
+			t.Errorf("Expected path '/adminAudit/eventCategories', got '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string][]string{"eventCategories": {"USERS", "LICENSES", "LOGINS"}})
+	})
+	defer server.Close()
+
+	categories, err := auditPlugin.ListEventCategories()
+	if err != nil {
+		t.Fatalf("Failed to list categories: %v", err)
+	}
+	if len(categories) != 3 || categories[2] != "LOGINS" {
+		t.Errorf("Unexpected categories: %v", categories)
+	}
+}
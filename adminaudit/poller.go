@@ -0,0 +1,234 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package adminaudit
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// Checkpoint records how far a Poller has delivered events. It is shared
+// with events.Stream.
+type Checkpoint = webexsdk.Checkpoint
+
+// CheckpointStore persists the Checkpoint of a Poller so that it resumes
+// where it left off after a restart.
+type CheckpointStore = webexsdk.CheckpointStore
+
+// MemoryCheckpointStore is a CheckpointStore that keeps the checkpoint in
+// memory. It does not survive restarts.
+type MemoryCheckpointStore = webexsdk.MemoryCheckpointStore
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoint as
+// JSON in a file. Saves replace the file atomically.
+type FileCheckpointStore = webexsdk.FileCheckpointStore
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return webexsdk.NewMemoryCheckpointStore()
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore for path. The file
+// is created on the first Save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return webexsdk.NewFileCheckpointStore(path)
+}
+
+// Handler receives the new events of a window, oldest first. If it
+// returns an error, the checkpoint is not advanced and the events are
+// delivered again by the next poll.
+type Handler func(ctx context.Context, events []Event) error
+
+// PollerConfig holds the configuration for a Poller.
+type PollerConfig struct {
+	// OrgID is the organization whose events are polled. Required.
+	OrgID string
+
+	// ActorID and EventCategories filter the events, as in ListOptions.
+	ActorID         string
+	EventCategories []string
+
+	// Store persists the checkpoint. Default: a MemoryCheckpointStore.
+	Store CheckpointStore
+
+	// Start is where polling begins when Store holds no checkpoint.
+	// Default: the time the Poller was created.
+	Start time.Time
+
+	// Interval is the time between polls in Run. Default: 1 minute.
+	Interval time.Duration
+
+	// Overlap is how far each window reaches back before the end of the
+	// previous one, so that events the API makes visible late are not
+	// missed. Events already delivered are skipped. Default: 5 minutes.
+	Overlap time.Duration
+
+	// MaxWindow caps the span of one from/to window, so that catching up
+	// after downtime proceeds in steps that are each checkpointed.
+	// Default: 24 hours; at least twice Overlap.
+	MaxWindow time.Duration
+
+	// PageSize is the max parameter of each page request. Zero uses the
+	// API's default.
+	PageSize int
+
+	// OnError, if set, is called with the error of a failed poll in Run,
+	// which then keeps polling. If nil, Run returns the error.
+	OnError func(error)
+}
+
+// Poller delivers the admin audit events of an organization incrementally.
+// It walks from/to windows up to the present, hands each window's new
+// events to a Handler and then saves a Checkpoint, so that a restarted
+// Poller neither loses nor repeats events: windows overlap by Overlap and
+// events recorded in the checkpoint are skipped.
+//
+// A Poller must not be used by several goroutines at once.
+type Poller struct {
+	client *Client
+	config *PollerConfig
+	store  CheckpointStore
+	now    func() time.Time
+}
+
+// NewPoller creates a Poller for the events configured by config.
+func (c *Client) NewPoller(config *PollerConfig) (*Poller, error) {
+	if config == nil || config.OrgID == "" {
+		return nil, fmt.Errorf("orgId is required")
+	}
+	if config.Start.IsZero() {
+		config.Start = time.Now()
+	}
+	if config.Interval <= 0 {
+		config.Interval = time.Minute
+	}
+	if config.Overlap <= 0 {
+		config.Overlap = 5 * time.Minute
+	}
+	if config.MaxWindow <= 0 {
+		config.MaxWindow = 24 * time.Hour
+	}
+	config.MaxWindow = max(config.MaxWindow, 2*config.Overlap)
+
+	store := config.Store
+	if store == nil {
+		store = NewMemoryCheckpointStore()
+	}
+
+	return &Poller{
+		client: c,
+		config: config,
+		store:  store,
+		now:    time.Now,
+	}, nil
+}
+
+// Poll delivers the events created since the checkpoint, up to now, to
+// handle, one window at a time. It saves the checkpoint after each window
+// that handle accepted.
+func (p *Poller) Poll(ctx context.Context, handle Handler) error {
+	checkpoint, err := p.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	first := checkpoint == nil
+	if first {
+		checkpoint = &Checkpoint{Until: p.config.Start}
+	}
+
+	now := p.now()
+	for checkpoint.Until.Before(now) {
+		from := checkpoint.Until.Add(-p.config.Overlap)
+		if first {
+			from = checkpoint.Until
+		}
+		to := checkpoint.Until.Add(p.config.MaxWindow)
+		if to.After(now) {
+			to = now
+		}
+
+		events, err := p.client.ListAll(ctx, &ListOptions{
+			OrgID:           p.config.OrgID,
+			From:            FormatTime(from),
+			To:              FormatTime(to),
+			ActorID:         p.config.ActorID,
+			EventCategories: p.config.EventCategories,
+			Max:             p.config.PageSize,
+		}).Collect()
+		if err != nil {
+			return err
+		}
+
+		fresh := p.fresh(events, checkpoint)
+		if len(fresh) > 0 {
+			if err := handle(ctx, fresh); err != nil {
+				return err
+			}
+		}
+
+		next := checkpoint.Clone()
+		for _, event := range fresh {
+			next.MarkSeen(event.ID, event.Created)
+		}
+		next.Advance(to, p.config.Overlap)
+		if err := p.store.Save(ctx, next); err != nil {
+			return fmt.Errorf("failed to save checkpoint: %w", err)
+		}
+		checkpoint, first = next, false
+	}
+	return nil
+}
+
+// fresh returns the events not in checkpoint, de-duplicated and sorted
+// oldest first.
+func (p *Poller) fresh(events []Event, checkpoint *Checkpoint) []Event {
+	seen := make(map[string]bool, len(events))
+	var fresh []Event
+	for _, event := range events {
+		if checkpoint.HasSeen(event.ID) || seen[event.ID] {
+			continue
+		}
+		seen[event.ID] = true
+		fresh = append(fresh, event)
+	}
+	slices.SortStableFunc(fresh, func(a, b Event) int {
+		return cmp.Or(a.Created.Compare(b.Created), cmp.Compare(a.ID, b.ID))
+	})
+	return fresh
+}
+
+// Run polls every Interval until ctx is done, delivering new events to
+// handle. It returns ctx's error, or the first error of a poll if OnError
+// is not set.
+func (p *Poller) Run(ctx context.Context, handle Handler) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if err := p.Poll(ctx, handle); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if p.config.OnError == nil {
+				return err
+			}
+			p.config.OnError(err)
+		}
+		timer.Reset(p.config.Interval)
+	}
+}
@@ -0,0 +1,186 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package adminaudit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// auditServer serves the events whose creation time falls in the
+// requested window, newest first, two per page.
+type auditServer struct {
+	mu      sync.Mutex
+	events  []Event
+	windows int
+	url     string
+}
+
+func (s *auditServer) add(id string, created time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append([]Event{{ID: id, Created: created}}, s.events...)
+}
+
+func (s *auditServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := r.URL.Query()
+	from, _ := time.Parse(time.RFC3339, q.Get("from"))
+	to, _ := time.Parse(time.RFC3339, q.Get("to"))
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	if offset == 0 {
+		s.windows++
+	}
+
+	var matching []Event
+	for _, event := range s.events {
+		if !event.Created.Before(from) && !event.Created.After(to) {
+			matching = append(matching, event)
+		}
+	}
+	end := min(offset+2, len(matching))
+	if end < len(matching) {
+		q.Set("offset", strconv.Itoa(end))
+		w.Header().Set("Link", fmt.Sprintf(`<%s/adminAudit/events?%s>; rel="next"`, s.url, q.Encode()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string][]Event{"items": matching[offset:end]})
+}
+
+func TestPoller(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := &auditServer{}
+	auditPlugin, server := newTestClient(t, fake.handle)
+	defer server.Close()
+	fake.url = server.URL
+
+	for i := 0; i < 5; i++ {
+		fake.add(fmt.Sprintf("event-%d", i), start.Add(time.Duration(i)*time.Hour))
+	}
+
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	newPoller := func() *Poller {
+		poller, err := auditPlugin.NewPoller(&PollerConfig{
+			OrgID:     "org-1",
+			Store:     store,
+			Start:     start,
+			Overlap:   10 * time.Minute,
+			MaxWindow: 2 * time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create poller: %v", err)
+		}
+		return poller
+	}
+
+	var delivered []string
+	handle := func(ctx context.Context, events []Event) error {
+		for _, event := range events {
+			delivered = append(delivered, event.ID)
+		}
+		return nil
+	}
+
+	now := start.Add(4*time.Hour + 30*time.Minute)
+	poller := newPoller()
+	poller.now = func() time.Time { return now }
+	if err := poller.Poll(context.Background(), handle); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	want := []string{"event-0", "event-1", "event-2", "event-3", "event-4"}
+	if fmt.Sprint(delivered) != fmt.Sprint(want) {
+		t.Fatalf("Expected %v oldest first, got %v", want, delivered)
+	}
+	if fake.windows != 3 {
+		t.Errorf("Expected 3 windows of at most 2h, got %d", fake.windows)
+	}
+
+	// An event that shows up late within the overlap is delivered once,
+	// by a restarted poller, without repeating the others
+	fake.add("late", now.Add(-5*time.Minute))
+	fake.add("event-5", now.Add(20*time.Minute))
+	now = now.Add(30 * time.Minute)
+	delivered = nil
+	poller = newPoller()
+	poller.now = func() time.Time { return now }
+	if err := poller.Poll(context.Background(), handle); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if fmt.Sprint(delivered) != "[late event-5]" {
+		t.Errorf("Expected only the new events, got %v", delivered)
+	}
+
+	checkpoint, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to load checkpoint: %v", err)
+	}
+	if !checkpoint.Until.Equal(now) || checkpoint.LastEventID != "event-5" {
+		t.Errorf("Unexpected checkpoint: %+v", checkpoint)
+	}
+
+	// A failed handler does not advance the checkpoint
+	fake.add("event-6", now.Add(time.Minute))
+	now = now.Add(5 * time.Minute)
+	handlerErr := errors.New("siem unavailable")
+	err = poller.Poll(context.Background(), func(ctx context.Context, events []Event) error { return handlerErr })
+	if !errors.Is(err, handlerErr) {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+	delivered = nil
+	if err := poller.Poll(context.Background(), handle); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if fmt.Sprint(delivered) != "[event-6]" {
+		t.Errorf("Expected event-6 to be delivered again, got %v", delivered)
+	}
+}
+
+func TestPollerRun(t *testing.T) {
+	fake := &auditServer{}
+	auditPlugin, server := newTestClient(t, fake.handle)
+	defer server.Close()
+	fake.url = server.URL
+
+	start := time.Now().Add(-time.Minute)
+	fake.add("event-1", start.Add(time.Second))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	poller, _ := auditPlugin.NewPoller(&PollerConfig{OrgID: "org-1", Start: start, Interval: time.Millisecond})
+
+	received := make(chan string, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.Run(ctx, func(ctx context.Context, events []Event) error {
+			received <- events[0].ID
+			return nil
+		})
+	}()
+
+	select {
+	case id := <-received:
+		if id != "event-1" {
+			t.Errorf("Expected event-1, got %s", id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected an event from Run")
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@
 package attachmentactions
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -55,6 +56,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Create submits an attachment action for a message with an adaptive card
 func (c *Client) Create(action *AttachmentAction) (*AttachmentAction, error) {
+	return c.CreateCtx(context.Background(), action)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, action *AttachmentAction) (*AttachmentAction, error) {
 	if action.MessageID == "" {
 		return nil, fmt.Errorf("messageId is required")
 	}
@@ -63,7 +69,7 @@ func (c *Client) Create(action *AttachmentAction) (*AttachmentAction, error) {
 		return nil, fmt.Errorf("type is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "attachment/actions", nil, action)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "attachment/actions", nil, action)
 	if err != nil {
 		return nil, err
 	}
@@ -78,12 +84,17 @@ func (c *Client) Create(action *AttachmentAction) (*AttachmentAction, error) {
 
 // Get returns a single attachment action by ID
 func (c *Client) Get(actionID string) (*AttachmentAction, error) {
+	return c.GetCtx(context.Background(), actionID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, actionID string) (*AttachmentAction, error) {
 	if actionID == "" {
 		return nil, fmt.Errorf("actionID is required")
 	}
 
 	path := fmt.Sprintf("attachment/actions/%s", actionID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
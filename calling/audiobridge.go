@@ -9,7 +9,7 @@ package calling
 import (
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -43,6 +43,10 @@ type AudioBridgeConfig struct {
 	// ICEServers for the browser-facing PeerConnection.
 	// Default: Google STUN server.
 	ICEServers []webrtc.ICEServer
+
+	// Logger receives the bridge's structured logs. If nil, slog.Default()
+	// is used. Pass core.Slog() to route through the Webex client's logger.
+	Logger *slog.Logger
 }
 
 // DefaultAudioBridgeConfig returns an AudioBridgeConfig with sensible defaults.
@@ -70,6 +74,7 @@ type AudioBridge struct {
 	localTrack *webrtc.TrackLocalStaticRTP // sends Mobius audio to browser
 
 	call        *Call
+	logger      *slog.Logger
 	stopRelay   chan struct{}
 	stopSilence chan struct{}
 	closed      bool
@@ -140,16 +145,22 @@ func NewAudioBridge(config *AudioBridgeConfig) (*AudioBridge, error) {
 		return nil, err
 	}
 
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
 	ab := &AudioBridge{
 		pc:          pc,
 		localTrack:  localTrack,
+		logger:      logger.With("component", "audiobridge"),
 		stopRelay:   make(chan struct{}),
 		stopSilence: make(chan struct{}),
 	}
 
 	// Wire up browser→Mobius relay on incoming browser track
 	pc.OnTrack(func(track *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) {
-		log.Printf("AudioBridge: received browser track codec=%s", track.Codec().MimeType)
+		ab.logger.Info("received browser track", "codec", track.Codec().MimeType)
 		go ab.relayBrowserToMobius(track)
 	})
 
@@ -168,7 +179,7 @@ func NewAudioBridge(config *AudioBridgeConfig) (*AudioBridge, error) {
 
 	// Wire up connection state change forwarding
 	pc.OnConnectionStateChange(func(s webrtc.PeerConnectionState) {
-		log.Printf("AudioBridge: browser PC state=%s", s.String())
+		ab.logger.Info("browser peer connection state changed", "state", s.String())
 		ab.mu.RLock()
 		handler := ab.onConnectionStateChange
 		ab.mu.RUnlock()
@@ -216,7 +227,7 @@ func (ab *AudioBridge) AttachCall(call *Call) {
 	ab.mu.Lock()
 	defer ab.mu.Unlock()
 	ab.call = call
-	log.Printf("AudioBridge: call attached (callId=%s)", call.GetCallID())
+	ab.logger.Info("call attached", "callId", call.GetCallID())
 }
 
 // DetachCall removes the current call from the bridge.
@@ -224,7 +235,7 @@ func (ab *AudioBridge) DetachCall() {
 	ab.mu.Lock()
 	defer ab.mu.Unlock()
 	ab.call = nil
-	log.Println("AudioBridge: call detached")
+	ab.logger.Info("call detached")
 }
 
 // GetCall returns the currently attached call, or nil.
@@ -252,7 +263,7 @@ func (ab *AudioBridge) HandleSignaling(transport SignalingTransport) error {
 		}
 		msgBytes, _ := json.Marshal(msg)
 		if err := transport.WriteMessage(msgBytes); err != nil {
-			log.Printf("AudioBridge: failed to send ICE candidate: %v", err)
+			ab.logger.Warn("failed to send ICE candidate", "error", err)
 		}
 	})
 
@@ -267,28 +278,28 @@ func (ab *AudioBridge) HandleSignaling(transport SignalingTransport) error {
 
 		var msg SignalingMessage
 		if err := json.Unmarshal(msgBytes, &msg); err != nil {
-			log.Printf("AudioBridge: invalid signaling message: %v", err)
+			ab.logger.Warn("invalid signaling message", "error", err)
 			continue
 		}
 
 		switch msg.Type {
 		case "offer":
-			log.Println("AudioBridge: received browser SDP offer")
+			ab.logger.Debug("received browser SDP offer")
 			if err := pc.SetRemoteDescription(webrtc.SessionDescription{
 				Type: webrtc.SDPTypeOffer,
 				SDP:  msg.SDP,
 			}); err != nil {
-				log.Printf("AudioBridge: set remote desc failed: %v", err)
+				ab.logger.Error("set remote description failed", "error", err)
 				continue
 			}
 
 			answer, err := pc.CreateAnswer(nil)
 			if err != nil {
-				log.Printf("AudioBridge: create answer failed: %v", err)
+				ab.logger.Error("create answer failed", "error", err)
 				continue
 			}
 			if err := pc.SetLocalDescription(answer); err != nil {
-				log.Printf("AudioBridge: set local desc failed: %v", err)
+				ab.logger.Error("set local description failed", "error", err)
 				continue
 			}
 
@@ -305,16 +316,16 @@ func (ab *AudioBridge) HandleSignaling(transport SignalingTransport) error {
 			if err := transport.WriteMessage(respBytes); err != nil {
 				return fmt.Errorf("signaling transport write: %w", err)
 			}
-			log.Println("AudioBridge: sent SDP answer to browser")
+			ab.logger.Debug("sent SDP answer to browser")
 
 		case "ice-candidate":
 			var candidate webrtc.ICECandidateInit
 			if err := json.Unmarshal(msg.Candidate, &candidate); err != nil {
-				log.Printf("AudioBridge: invalid ICE candidate: %v", err)
+				ab.logger.Warn("invalid ICE candidate", "error", err)
 				continue
 			}
 			if err := pc.AddICECandidate(candidate); err != nil {
-				log.Printf("AudioBridge: add ICE candidate failed: %v", err)
+				ab.logger.Warn("add ICE candidate failed", "error", err)
 			}
 		}
 	}
@@ -349,7 +360,7 @@ func (ab *AudioBridge) relayBrowserToMobius(track *webrtc.TrackRemote) {
 	for {
 		n, _, readErr := track.Read(buf)
 		if readErr != nil {
-			log.Printf("AudioBridge: browser track read ended: %v (relayed %d packets to Mobius)", readErr, pktCount)
+			ab.logger.Info("browser track read ended", "packetsRelayed", pktCount, "error", readErr)
 			return
 		}
 
@@ -383,14 +394,14 @@ func (ab *AudioBridge) relayBrowserToMobius(track *webrtc.TrackRemote) {
 		}
 		if writeErr := mobiusLocalTrack.WriteRTP(pkt); writeErr != nil {
 			if pktCount == 0 || pktCount%500 == 0 {
-				log.Printf("AudioBridge: write to Mobius failed (pkt %d): %v", pktCount, writeErr)
+				ab.logger.Warn("write to Mobius failed", "packet", pktCount, "error", writeErr)
 			}
 		} else {
 			pktCount++
 			if pktCount == 1 {
-				log.Printf("AudioBridge: first RTP packet relayed browser→Mobius (pt=%d ssrc=%d)", pkt.PayloadType, pkt.SSRC)
+				ab.logger.Debug("first RTP packet relayed browser→Mobius", "payloadType", pkt.PayloadType, "ssrc", pkt.SSRC)
 			} else if pktCount%500 == 0 {
-				log.Printf("AudioBridge: relayed %d packets browser→Mobius", pktCount)
+				ab.logger.Debug("relayed packets browser→Mobius", "packets", pktCount)
 			}
 		}
 	}
@@ -427,12 +438,12 @@ func (ab *AudioBridge) silenceKeepalive() {
 				},
 				Payload: silenceBuf,
 			}); writeErr != nil {
-				log.Printf("AudioBridge: silence write error: %v (after %d packets)", writeErr, silenceCount)
+				ab.logger.Warn("silence write error", "packets", silenceCount, "error", writeErr)
 				return
 			}
 			silenceCount++
 			if silenceCount == 1 {
-				log.Println("AudioBridge: silence keepalive started")
+				ab.logger.Debug("silence keepalive started")
 			}
 		}
 	}
@@ -460,13 +471,13 @@ func (ab *AudioBridge) relayMobiusToBrowser() {
 				continue
 			}
 			ticker.Stop()
-			log.Println("AudioBridge: starting Mobius→Browser relay")
+			ab.logger.Debug("starting Mobius→browser relay")
 			silenceStopped := false
 			buf := make([]byte, 1500)
 			for {
 				n, _, readErr := remoteTrack.Read(buf)
 				if readErr != nil {
-					log.Printf("AudioBridge: Mobius remote track read ended: %v", readErr)
+					ab.logger.Info("Mobius remote track read ended", "error", readErr)
 					return
 				}
 				pkt := &rtp.Packet{}
@@ -475,14 +486,14 @@ func (ab *AudioBridge) relayMobiusToBrowser() {
 				}
 				// Write directly — preserves original packet timing
 				if writeErr := ab.localTrack.WriteRTP(pkt); writeErr != nil {
-					log.Printf("AudioBridge: Mobius→Browser write error: %v", writeErr)
+					ab.logger.Warn("Mobius→browser write error", "error", writeErr)
 					return
 				}
 				// Stop silence only after first real packet is written
 				if !silenceStopped {
 					close(ab.stopSilence)
 					silenceStopped = true
-					log.Println("AudioBridge: silence keepalive stopped, real audio flowing")
+					ab.logger.Debug("silence keepalive stopped, real audio flowing")
 				}
 			}
 		}
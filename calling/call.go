@@ -8,12 +8,14 @@ package calling
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
 	"github.com/google/uuid"
@@ -52,6 +54,9 @@ type Call struct {
 
 	// Events
 	Emitter *EventEmitter
+
+	// Structured logger carrying the call's correlationId
+	logger *slog.Logger
 }
 
 // CallDetails contains the destination for an outbound call
@@ -80,7 +85,20 @@ func NewCall(core *webexsdk.Client, direction CallDirection, destination *CallDe
 		return nil, fmt.Errorf("call config is required")
 	}
 
-	mediaEngine, err := NewMediaEngine(config.MediaConfig)
+	correlationID := uuid.New().String()
+	logger := core.Slog().With("component", "calling", "correlationId", correlationID)
+
+	// Route media logs through the call's logger unless configured otherwise
+	mediaConfig := DefaultMediaConfig()
+	if config.MediaConfig != nil {
+		copied := *config.MediaConfig
+		mediaConfig = &copied
+	}
+	if mediaConfig.Logger == nil {
+		mediaConfig.Logger = logger
+	}
+
+	mediaEngine, err := NewMediaEngine(mediaConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create media engine: %w", err)
 	}
@@ -88,7 +106,8 @@ func NewCall(core *webexsdk.Client, direction CallDirection, destination *CallDe
 	c := &Call{
 		core:            core,
 		callID:          fmt.Sprintf("DefaultLocalId_%s", uuid.New().String()),
-		correlationID:   uuid.New().String(),
+		correlationID:   correlationID,
+		logger:          logger,
 		lineID:          config.LineID,
 		deviceID:        config.DeviceID,
 		direction:       direction,
@@ -173,7 +192,38 @@ func (c *Call) GetMedia() *MediaEngine {
 
 // Dial initiates an outbound call.
 // It creates a WebRTC offer, wraps it in ROAP, and POSTs to Mobius.
+// Call setup is recorded as a webexsdk.SpanCallDial span.
 func (c *Call) Dial() error {
+	return c.DialCtx(context.Background())
+}
+
+// DialCtx is like Dial but uses ctx for the Mobius requests.
+func (c *Call) DialCtx(ctx context.Context) error {
+	start := time.Now()
+	ctx, span := c.core.Tracer().Start(ctx, webexsdk.SpanCallDial,
+		webexsdk.Attr("webex.calling.correlation_id", c.correlationID))
+
+	err := c.dial(ctx)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+		span.RecordError(err)
+	}
+	span.SetAttributes(
+		webexsdk.Attr("webex.calling.call_id", c.GetCallID()),
+		webexsdk.Attr(webexsdk.AttrOutcome, outcome),
+	)
+	span.End()
+	c.core.Meter().RecordDuration(ctx, webexsdk.MetricCallDialDuration, time.Since(start),
+		webexsdk.Attr(webexsdk.AttrOutcome, outcome))
+
+	return err
+}
+
+// dial implements Dial. Its Mobius requests are sent with ctx so that they
+// are recorded under the dial span.
+func (c *Call) dial(ctx context.Context) error {
 	c.mu.Lock()
 	if c.state != CallStateIdle {
 		c.mu.Unlock()
@@ -195,17 +245,17 @@ func (c *Call) Dial() error {
 
 	// Filter IPv6 for compatibility
 	sdp = ModifySdpForMobius(sdp)
-	log.Printf("Outgoing SDP offer:\n--- Our SDP Offer ---\n%s\n--- End SDP ---", sdp)
+	c.logger.Debug("outgoing SDP offer", "sdp", sdp)
 
 	// Set up remote track handler BEFORE postCall so we don't miss the track
 	c.media.OnRemoteTrack(func(track *webrtc.TrackRemote) {
-		log.Printf("Mobius remote track received: codec=%s", track.Codec().MimeType)
+		c.logger.Info("Mobius remote track received", "codec", track.Codec().MimeType)
 		c.Emitter.Emit(string(CallEventRemoteMedia), track)
 	})
 
 	// Wrap in ROAP and POST to Mobius
 	roapMsg := SDPToRoapOffer(sdp, c.seq)
-	resp, err := c.postCall(roapMsg)
+	resp, err := c.postCall(ctx, roapMsg)
 	if err != nil {
 		c.mu.Lock()
 		c.state = CallStateDisconnected
@@ -219,26 +269,26 @@ func (c *Call) Dial() error {
 	c.seq++
 	c.mu.Unlock()
 
-	log.Printf("Call setup successful, callId=%s correlationId=%s", resp.Body.CallID, c.correlationID)
+	c.logger.Info("call setup successful", "callId", resp.Body.CallID)
 
 	// Process the ROAP answer from Mobius to complete the WebRTC handshake
 	if resp.Body.LocalMedia != nil && resp.Body.LocalMedia.Roap != nil {
 		roap := resp.Body.LocalMedia.Roap
-		log.Printf("Received ROAP %s from Mobius (seq=%d, sdp length=%d)", roap.MessageType, roap.Seq, len(roap.SDP))
+		c.logger.Debug("received ROAP message from Mobius", "type", roap.MessageType, "seq", roap.Seq, "sdpLength", len(roap.SDP))
 		if roap.MessageType == RoapMessageAnswer && roap.SDP != "" {
 			if err := c.media.SetRemoteAnswer(roap.SDP); err != nil {
-				log.Printf("Failed to set remote answer from Mobius: %v", err)
+				c.logger.Error("failed to set remote answer from Mobius", "error", err)
 			} else {
-				log.Printf("Set remote SDP answer from Mobius, WebRTC handshake complete")
+				c.logger.Debug("set remote SDP answer from Mobius, WebRTC handshake complete")
 				// Send ROAP OK back to Mobius
 				okMsg := NewRoapOK(roap.Seq)
-				if err := c.postMedia(okMsg); err != nil {
-					log.Printf("Failed to send ROAP OK: %v", err)
+				if err := c.postMedia(ctx, okMsg); err != nil {
+					c.logger.Error("failed to send ROAP OK", "callId", c.callID, "error", err)
 				}
 			}
 		}
 	} else {
-		log.Printf("No ROAP answer in Mobius call response — media negotiation may happen via events")
+		c.logger.Debug("no ROAP answer in Mobius call response, media negotiation may happen via events")
 	}
 
 	c.Emitter.Emit(string(CallEventProgress), c.callID)
@@ -248,6 +298,11 @@ func (c *Call) Dial() error {
 // Answer answers an incoming call.
 // It sets the remote SDP offer, creates an answer, and sends it via ROAP.
 func (c *Call) Answer(remoteOffer string) error {
+	return c.AnswerCtx(context.Background(), remoteOffer)
+}
+
+// AnswerCtx is like Answer but uses ctx for the Mobius request.
+func (c *Call) AnswerCtx(ctx context.Context, remoteOffer string) error {
 	c.mu.Lock()
 	if c.state != CallStateAlerting && c.state != CallStateIdle {
 		c.mu.Unlock()
@@ -275,7 +330,7 @@ func (c *Call) Answer(remoteOffer string) error {
 
 	// Send ROAP answer to Mobius via media endpoint
 	roapMsg := SDPToRoapAnswer(sdp, c.seq)
-	if err := c.postMedia(roapMsg); err != nil {
+	if err := c.postMedia(ctx, roapMsg); err != nil {
 		return fmt.Errorf("failed to send answer to Mobius: %w", err)
 	}
 
@@ -297,6 +352,11 @@ func (c *Call) Answer(remoteOffer string) error {
 
 // End disconnects the call
 func (c *Call) End() error {
+	return c.EndCtx(context.Background())
+}
+
+// EndCtx is like End but uses ctx for the Mobius hang-up request.
+func (c *Call) EndCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.state == CallStateDisconnected {
 		c.mu.Unlock()
@@ -309,15 +369,15 @@ func (c *Call) End() error {
 
 	// Send disconnect to Mobius if we were connected
 	if prevState == CallStateConnected || prevState == CallStateHeld || prevState == CallStateProceeding || prevState == CallStateAlerting {
-		if err := c.deleteCall(); err != nil {
-			log.Printf("Error sending disconnect to Mobius: %v", err)
+		if err := c.deleteCall(ctx); err != nil {
+			c.logger.Error("error sending disconnect to Mobius", "callId", c.callID, "error", err)
 		}
 	}
 
 	// Close media
 	if c.media != nil {
 		if err := c.media.Close(); err != nil {
-			log.Printf("Error closing media: %v", err)
+			c.logger.Warn("error closing media", "callId", c.callID, "error", err)
 		}
 	}
 
@@ -347,6 +407,11 @@ func (c *Call) Unmute() {
 
 // Hold puts the call on hold
 func (c *Call) Hold() error {
+	return c.HoldCtx(context.Background())
+}
+
+// HoldCtx is like Hold but uses ctx for the Mobius request.
+func (c *Call) HoldCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.state != CallStateConnected {
 		c.mu.Unlock()
@@ -354,7 +419,7 @@ func (c *Call) Hold() error {
 	}
 	c.mu.Unlock()
 
-	if err := c.postSupplementaryService("callhold", "hold"); err != nil {
+	if err := c.postSupplementaryService(ctx, "callhold", "hold"); err != nil {
 		c.Emitter.Emit(string(CallEventHoldError), err)
 		return fmt.Errorf("hold request failed: %w", err)
 	}
@@ -370,6 +435,11 @@ func (c *Call) Hold() error {
 
 // Resume resumes a held call
 func (c *Call) Resume() error {
+	return c.ResumeCtx(context.Background())
+}
+
+// ResumeCtx is like Resume but uses ctx for the Mobius request.
+func (c *Call) ResumeCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.state != CallStateHeld {
 		c.mu.Unlock()
@@ -377,7 +447,7 @@ func (c *Call) Resume() error {
 	}
 	c.mu.Unlock()
 
-	if err := c.postSupplementaryService("callhold", "resume"); err != nil {
+	if err := c.postSupplementaryService(ctx, "callhold", "resume"); err != nil {
 		c.Emitter.Emit(string(CallEventResumeError), err)
 		return fmt.Errorf("resume request failed: %w", err)
 	}
@@ -401,6 +471,11 @@ func (c *Call) DoHoldResume() error {
 
 // SendDigit sends a DTMF digit during the call
 func (c *Call) SendDigit(tone string) error {
+	return c.SendDigitCtx(context.Background(), tone)
+}
+
+// SendDigitCtx is like SendDigit but uses ctx for the Mobius request.
+func (c *Call) SendDigitCtx(ctx context.Context, tone string) error {
 	c.mu.RLock()
 	if c.state != CallStateConnected {
 		c.mu.RUnlock()
@@ -420,6 +495,7 @@ func (c *Call) SendDigit(tone string) error {
 	}
 
 	return c.postToMobius(
+		ctx,
 		fmt.Sprintf("%sdevices/%s/calls/%s/dtmf", c.mobiusURL, c.deviceID, c.callID),
 		payload,
 	)
@@ -427,6 +503,11 @@ func (c *Call) SendDigit(tone string) error {
 
 // CompleteTransfer completes a call transfer (blind or consult)
 func (c *Call) CompleteTransfer(transferType TransferType, transferCallID, transferTarget string) error {
+	return c.CompleteTransferCtx(context.Background(), transferType, transferCallID, transferTarget)
+}
+
+// CompleteTransferCtx is like CompleteTransfer but uses ctx for the Mobius request.
+func (c *Call) CompleteTransferCtx(ctx context.Context, transferType TransferType, transferCallID, transferTarget string) error {
 	c.mu.RLock()
 	if c.state != CallStateConnected && c.state != CallStateHeld {
 		c.mu.RUnlock()
@@ -460,7 +541,7 @@ func (c *Call) CompleteTransfer(transferType TransferType, transferCallID, trans
 	}
 
 	url := fmt.Sprintf("%sservices/calltransfer/commit", c.mobiusURL)
-	if err := c.postToMobius(url, payload); err != nil {
+	if err := c.postToMobius(ctx, url, payload); err != nil {
 		c.Emitter.Emit(string(CallEventTransferError), err)
 		return fmt.Errorf("transfer failed: %w", err)
 	}
@@ -470,6 +551,11 @@ func (c *Call) CompleteTransfer(transferType TransferType, transferCallID, trans
 
 // PostStatus sends a call keepalive/status to Mobius
 func (c *Call) PostStatus() error {
+	return c.PostStatusCtx(context.Background())
+}
+
+// PostStatusCtx is like PostStatus but uses ctx for the Mobius request.
+func (c *Call) PostStatusCtx(ctx context.Context) error {
 	payload := map[string]interface{}{
 		"device": map[string]string{
 			"deviceId":      c.deviceID,
@@ -479,11 +565,17 @@ func (c *Call) PostStatus() error {
 	}
 
 	url := fmt.Sprintf("%sdevices/%s/calls/%s/status", c.mobiusURL, c.deviceID, c.callID)
-	return c.postToMobius(url, payload)
+	return c.postToMobius(ctx, url, payload)
 }
 
 // HandleMobiusEvent processes an incoming Mobius WebSocket event for this call
 func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
+	c.HandleMobiusEventCtx(context.Background(), event)
+}
+
+// HandleMobiusEventCtx is like HandleMobiusEvent but uses ctx for any Mobius
+// requests the event triggers, such as ROAP answers.
+func (c *Call) HandleMobiusEventCtx(ctx context.Context, event *MobiusCallEvent) {
 	if event == nil {
 		return
 	}
@@ -501,7 +593,7 @@ func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
 
 		// Handle ROAP message in progress
 		if data.Message != nil {
-			c.handleRoapMessage(data.Message)
+			c.handleRoapMessage(ctx, data.Message)
 		}
 
 	case MobiusEventCallConnected:
@@ -514,7 +606,7 @@ func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
 
 	case MobiusEventCallMedia:
 		if data.Message != nil {
-			c.handleRoapMessage(data.Message)
+			c.handleRoapMessage(ctx, data.Message)
 		}
 
 	case MobiusEventCallDisconnected:
@@ -534,7 +626,7 @@ func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
 		alreadyConnected := c.connected
 		c.mu.RUnlock()
 		if alreadyConnected {
-			log.Printf("[INCOMING] Ignoring redundant call setup for already-connected callId=%s", c.callID)
+			c.logger.Debug("ignoring redundant call setup for connected call", "callId", c.callID)
 			return
 		}
 
@@ -544,9 +636,9 @@ func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
 		c.state = CallStateAlerting
 		c.mu.Unlock()
 
-		log.Printf("[INCOMING] Call setup received, PATCHing sig_alerting for callId=%s", c.callID)
-		if err := c.patchCallState("sig_alerting"); err != nil {
-			log.Printf("[INCOMING] Failed to PATCH sig_alerting: %v", err)
+		c.logger.Info("incoming call setup received, sending sig_alerting", "callId", c.callID)
+		if err := c.patchCallState(ctx, "sig_alerting"); err != nil {
+			c.logger.Error("failed to PATCH sig_alerting", "callId", c.callID, "error", err)
 		}
 
 		c.Emitter.Emit(string(CallEventAlerting), c.callID)
@@ -567,7 +659,7 @@ func (c *Call) HandleMobiusEvent(event *MobiusCallEvent) {
 }
 
 // handleRoapMessage processes a ROAP message from Mobius
-func (c *Call) handleRoapMessage(msg *RoapMessage) {
+func (c *Call) handleRoapMessage(ctx context.Context, msg *RoapMessage) {
 	if msg == nil {
 		return
 	}
@@ -575,23 +667,23 @@ func (c *Call) handleRoapMessage(msg *RoapMessage) {
 	switch msg.MessageType {
 	case RoapMessageAnswer:
 		// Remote sent an answer to our offer
-		log.Printf("ROAP ANSWER received (seq=%d, sdp length=%d) for callId=%s\n--- Mobius SDP Answer ---\n%s\n--- End SDP ---", msg.Seq, len(msg.SDP), c.callID, msg.SDP)
+		c.logger.Debug("ROAP answer received", "callId", c.callID, "seq", msg.Seq, "sdp", msg.SDP)
 		if err := c.media.SetRemoteAnswer(msg.SDP); err != nil {
-			log.Printf("Failed to set remote answer: %v", err)
+			c.logger.Error("failed to set remote answer", "callId", c.callID, "error", err)
 			c.Emitter.Emit(string(CallEventError), err)
 			return
 		}
-		log.Printf("Remote SDP answer set successfully, Mobius WebRTC handshake completing for callId=%s", c.callID)
+		c.logger.Debug("remote SDP answer set, WebRTC handshake completing", "callId", c.callID)
 		// Send ROAP OK
 		okMsg := NewRoapOK(msg.Seq)
-		if err := c.postMedia(okMsg); err != nil {
-			log.Printf("Failed to send ROAP OK: %v", err)
+		if err := c.postMedia(ctx, okMsg); err != nil {
+			c.logger.Error("failed to send ROAP OK", "callId", c.callID, "error", err)
 		} else {
-			log.Printf("ROAP OK sent successfully for callId=%s seq=%d", c.callID, msg.Seq)
+			c.logger.Debug("ROAP OK sent", "callId", c.callID, "seq", msg.Seq)
 		}
 
 	case RoapMessageOffer:
-		log.Printf("[INCOMING] ROAP OFFER received (seq=%d, sdp length=%d) for callId=%s direction=%s", msg.Seq, len(msg.SDP), c.callID, c.direction)
+		c.logger.Debug("ROAP offer received", "callId", c.callID, "seq", msg.Seq, "sdpLength", len(msg.SDP), "direction", c.direction)
 
 		// For incoming calls that haven't been connected yet, we must:
 		// 1. Add audio track
@@ -602,12 +694,12 @@ func (c *Call) handleRoapMessage(msg *RoapMessage) {
 
 		// Ensure audio track exists before setting the remote offer
 		if c.media.GetLocalTrack() == nil {
-			log.Printf("[INCOMING] Adding audio track")
+			c.logger.Debug("adding audio track for incoming offer", "callId", c.callID)
 			if _, err := c.media.AddAudioTrack(); err != nil {
-				log.Printf("Failed to add audio track for incoming offer: %v", err)
+				c.logger.Error("failed to add audio track for incoming offer", "callId", c.callID, "error", err)
 				return
 			}
-			log.Printf("[INCOMING] Audio track added successfully")
+			c.logger.Debug("audio track added", "callId", c.callID)
 		}
 
 		// Register remote track handler BEFORE SetRemoteOffer — OnTrack can
@@ -622,32 +714,32 @@ func (c *Call) handleRoapMessage(msg *RoapMessage) {
 		// PATCH sig_connected BEFORE sending ROAP answer — Mobius rejects media
 		// when the call state is still ALERT/PROGRESS (error 400).
 		if isInitialInbound {
-			log.Printf("[INCOMING] PATCHing call to connected state")
-			if err := c.patchCallState("sig_connected"); err != nil {
-				log.Printf("[INCOMING] Failed to PATCH call state to connected: %v", err)
+			c.logger.Debug("sending sig_connected for incoming call", "callId", c.callID)
+			if err := c.patchCallState(ctx, "sig_connected"); err != nil {
+				c.logger.Error("failed to PATCH call state to connected", "callId", c.callID, "error", err)
 			}
 		}
 
 		if err := c.media.SetRemoteOffer(msg.SDP); err != nil {
-			log.Printf("Failed to set remote offer: %v", err)
+			c.logger.Error("failed to set remote offer", "callId", c.callID, "error", err)
 			return
 		}
 
 		sdp, err := c.media.CreateAnswer()
 		if err != nil {
-			log.Printf("Failed to create answer: %v", err)
+			c.logger.Error("failed to create answer", "callId", c.callID, "error", err)
 			return
 		}
 
 		sdp = ModifySdpForMobius(sdp)
 
 		answerMsg := SDPToRoapAnswer(sdp, msg.Seq)
-		log.Printf("[INCOMING] Sending ROAP answer with seq=%d to callId=%s", msg.Seq, c.callID)
-		if err := c.postMedia(answerMsg); err != nil {
-			log.Printf("Failed to send ROAP answer: %v", err)
+		c.logger.Debug("sending ROAP answer", "callId", c.callID, "seq", msg.Seq)
+		if err := c.postMedia(ctx, answerMsg); err != nil {
+			c.logger.Error("failed to send ROAP answer", "callId", c.callID, "error", err)
 			return
 		}
-		log.Printf("[INCOMING] ROAP answer sent successfully")
+		c.logger.Debug("ROAP answer sent", "callId", c.callID)
 
 		// Transition local state for initial inbound calls
 		if isInitialInbound {
@@ -663,18 +755,18 @@ func (c *Call) handleRoapMessage(msg *RoapMessage) {
 
 	case RoapMessageOK:
 		// Media negotiation complete
-		log.Printf("ROAP OK received, media negotiation complete for callId=%s", c.callID)
+		c.logger.Info("media negotiation complete", "callId", c.callID)
 
 	case RoapMessageOfferRequest:
 		// Server requests a new offer
 		sdp, err := c.media.CreateOffer()
 		if err != nil {
-			log.Printf("Failed to create offer for offer request: %v", err)
+			c.logger.Error("failed to create offer for offer request", "callId", c.callID, "error", err)
 			return
 		}
 		offerMsg := SDPToRoapOffer(sdp, msg.Seq)
-		if err := c.postMedia(offerMsg); err != nil {
-			log.Printf("Failed to send ROAP offer: %v", err)
+		if err := c.postMedia(ctx, offerMsg); err != nil {
+			c.logger.Error("failed to send ROAP offer", "callId", c.callID, "error", err)
 		}
 	}
 }
@@ -682,7 +774,7 @@ func (c *Call) handleRoapMessage(msg *RoapMessage) {
 // ---- Mobius HTTP API Methods ----
 
 // postCall sends a POST to create a new call with Mobius
-func (c *Call) postCall(roapMsg *RoapMessage) (*MobiusCallResponse, error) {
+func (c *Call) postCall(ctx context.Context, roapMsg *RoapMessage) (*MobiusCallResponse, error) {
 	basePayload := map[string]interface{}{
 		"device": map[string]string{
 			"deviceId":      c.deviceID,
@@ -708,14 +800,14 @@ func (c *Call) postCall(roapMsg *RoapMessage) (*MobiusCallResponse, error) {
 		return nil, fmt.Errorf("error marshaling call payload: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating call request: %w", err)
 	}
 
 	c.setMobiusHeaders(req)
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making call request: %w", err)
 	}
@@ -730,7 +822,7 @@ func (c *Call) postCall(roapMsg *RoapMessage) (*MobiusCallResponse, error) {
 		return nil, fmt.Errorf("call request failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
-	log.Printf("Mobius call response (status %d): %s", resp.StatusCode, string(body))
+	c.logger.Debug("Mobius call response", "status", resp.StatusCode, "body", string(body))
 
 	var callResp MobiusCallResponse
 	callResp.StatusCode = resp.StatusCode
@@ -742,7 +834,7 @@ func (c *Call) postCall(roapMsg *RoapMessage) (*MobiusCallResponse, error) {
 }
 
 // postMedia sends a ROAP message to the media endpoint
-func (c *Call) postMedia(roapMsg *RoapMessage) error {
+func (c *Call) postMedia(ctx context.Context, roapMsg *RoapMessage) error {
 	payload := map[string]interface{}{
 		"device": map[string]string{
 			"deviceId":      c.deviceID,
@@ -756,21 +848,21 @@ func (c *Call) postMedia(roapMsg *RoapMessage) error {
 	}
 
 	url := fmt.Sprintf("%sdevices/%s/calls/%s/media", c.mobiusURL, c.deviceID, c.callID)
-	return c.postToMobius(url, payload)
+	return c.postToMobius(ctx, url, payload)
 }
 
 // deleteCall sends a DELETE to disconnect the call
-func (c *Call) deleteCall() error {
+func (c *Call) deleteCall(ctx context.Context) error {
 	url := fmt.Sprintf("%sdevices/%s/calls/%s", c.mobiusURL, c.deviceID, c.callID)
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating delete request: %w", err)
 	}
 
 	c.setMobiusHeaders(req)
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return fmt.Errorf("error making delete request: %w", err)
 	}
@@ -780,7 +872,7 @@ func (c *Call) deleteCall() error {
 }
 
 // postSupplementaryService sends a supplementary service request (hold/resume/transfer)
-func (c *Call) postSupplementaryService(service, action string) error {
+func (c *Call) postSupplementaryService(ctx context.Context, service, action string) error {
 	payload := map[string]interface{}{
 		"device": map[string]string{
 			"deviceId":      c.deviceID,
@@ -790,24 +882,24 @@ func (c *Call) postSupplementaryService(service, action string) error {
 	}
 
 	url := fmt.Sprintf("%sservices/%s/%s", c.mobiusURL, service, action)
-	return c.postToMobius(url, payload)
+	return c.postToMobius(ctx, url, payload)
 }
 
 // postToMobius is a generic helper for POST requests to Mobius
-func (c *Call) postToMobius(url string, payload interface{}) error {
+func (c *Call) postToMobius(ctx context.Context, url string, payload interface{}) error {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("error marshaling payload: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
 	c.setMobiusHeaders(req)
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return fmt.Errorf("error making request: %w", err)
 	}
@@ -823,7 +915,7 @@ func (c *Call) postToMobius(url string, payload interface{}) error {
 // patchCallState sends a PATCH to Mobius to transition the call state.
 // This is required for incoming calls: Mobius must be told the call is "connected"
 // before media answers can be sent (mirrors the JS SDK's answer flow).
-func (c *Call) patchCallState(state string) error {
+func (c *Call) patchCallState(ctx context.Context, state string) error {
 	payload := map[string]interface{}{
 		"device": map[string]string{
 			"deviceId":      c.deviceID,
@@ -840,16 +932,16 @@ func (c *Call) patchCallState(state string) error {
 	}
 
 	url := fmt.Sprintf("%sdevices/%s/calls/%s", c.mobiusURL, c.deviceID, c.callID)
-	log.Printf("PATCH callState=%s for callId=%s", state, c.callID)
+	c.logger.Debug("updating call state", "callId", c.callID, "callState", state)
 
-	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("error creating PATCH request: %w", err)
 	}
 
 	c.setMobiusHeaders(req)
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return fmt.Errorf("error making PATCH request: %w", err)
 	}
@@ -872,5 +964,5 @@ func (c *Call) setMobiusHeaders(req *http.Request) {
 	if c.clientDeviceURI != "" {
 		req.Header.Set("cisco-device-url", c.clientDeviceURI)
 	}
-	req.Header.Set("trackingid", fmt.Sprintf("webex-go-sdk_%s", uuid.New().String()))
+	req.Header.Set(webexsdk.TrackingIDHeader, c.core.TrackingID(req.Context()))
 }
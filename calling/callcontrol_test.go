@@ -7,9 +7,17 @@
 package calling
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 
@@ -342,6 +350,171 @@ func TestLine(t *testing.T) {
 		_ = line.Deregister()
 	})
 
+	t.Run("Register goes through core middleware", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Trace") != "trace-1" {
+				t.Errorf("Expected X-Trace header from middleware on %s", r.Method)
+			}
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodPost {
+				_ = json.NewEncoder(w).Encode(MobiusDeviceInfo{
+					Device: &DeviceType{DeviceID: "device-abc"},
+				})
+			}
+		}))
+		defer server.Close()
+
+		var methods []string
+		core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+			Middleware: []webexsdk.Middleware{func(next http.RoundTripper) http.RoundTripper {
+				return webexsdk.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					methods = append(methods, req.Method)
+					req.Header.Set("X-Trace", "trace-1")
+					return next.RoundTrip(req)
+				})
+			}},
+		})
+		line := NewLine(core, nil, &LineConfig{
+			PrimaryMobiusURLs: []string{server.URL + "/"},
+			ClientDeviceURI:   "https://wdm/devices/test",
+		})
+
+		if err := line.Register(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		_ = line.Deregister()
+
+		if len(methods) < 2 || methods[0] != http.MethodPost {
+			t.Errorf("Expected register and deregister through middleware, got %v", methods)
+		}
+	})
+
+	t.Run("Register uses core tracking IDs", func(t *testing.T) {
+		var trackingIDs []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			trackingIDs = append(trackingIDs, r.Header.Get(webexsdk.TrackingIDHeader))
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodPost {
+				_ = json.NewEncoder(w).Encode(MobiusDeviceInfo{
+					Device: &DeviceType{DeviceID: "device-abc"},
+				})
+			}
+		}))
+		defer server.Close()
+
+		core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+			TrackingIDGenerator: func() string { return "calling-test" },
+		})
+		line := NewLine(core, nil, &LineConfig{
+			PrimaryMobiusURLs: []string{server.URL + "/"},
+			ClientDeviceURI:   "https://wdm/devices/test",
+		})
+
+		if err := line.Register(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		_ = line.Deregister()
+
+		if len(trackingIDs) < 2 {
+			t.Fatalf("Expected register and deregister requests, got %d", len(trackingIDs))
+		}
+		for _, id := range trackingIDs {
+			if id != "calling-test" {
+				t.Errorf("Expected tracking ID from core generator, got %q", id)
+			}
+		}
+	})
+
+	t.Run("Register replays from core cassette", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodPost {
+				_ = json.NewEncoder(w).Encode(MobiusDeviceInfo{
+					Device: &DeviceType{DeviceID: "device-abc"},
+				})
+			}
+		}))
+
+		path := filepath.Join(t.TempDir(), "register.json")
+		register := func(mode webexsdk.CassetteMode) *Line {
+			cassette, err := webexsdk.NewCassette(&webexsdk.CassetteConfig{Path: path, Mode: mode})
+			if err != nil {
+				t.Fatalf("Failed to create cassette: %v", err)
+			}
+			core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{Cassette: cassette})
+			line := NewLine(core, nil, &LineConfig{
+				PrimaryMobiusURLs: []string{server.URL + "/"},
+				ClientDeviceURI:   "https://wdm/devices/test",
+			})
+			if err := line.Register(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			return line
+		}
+
+		register(webexsdk.CassetteRecord)
+		server.Close()
+
+		line := register(webexsdk.CassetteReplay)
+		if line.GetDeviceID() != "device-abc" {
+			t.Errorf("Expected replayed device ID, got %q", line.GetDeviceID())
+		}
+	})
+
+	t.Run("Register fails fast on open Mobius circuit", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+			CircuitBreaker: webexsdk.NewCircuitBreaker(&webexsdk.CircuitBreakerConfig{FailureThreshold: 2}),
+		})
+		line := NewLine(core, nil, &LineConfig{
+			PrimaryMobiusURLs: []string{server.URL + "/"},
+			ClientDeviceURI:   "https://wdm/devices/test",
+		})
+
+		for i := 0; i < 2; i++ {
+			if err := line.Register(); err == nil || webexsdk.IsCircuitOpen(err) {
+				t.Fatalf("Expected Mobius failure, got %v", err)
+			}
+		}
+		err := line.Register()
+		if !errors.Is(err, webexsdk.ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen, got %v", err)
+		}
+		if requests != 2 {
+			t.Errorf("Expected 2 requests to reach Mobius, got %d", requests)
+		}
+	})
+
+	t.Run("Register logs through core structured logger", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+			Slog: slog.New(slog.NewJSONHandler(&buf, nil)),
+		})
+		line := NewLine(core, nil, &LineConfig{
+			PrimaryMobiusURLs: []string{server.URL + "/"},
+			ClientDeviceURI:   "https://wdm/devices/test",
+		})
+
+		if err := line.Register(); err == nil {
+			t.Fatal("Expected registration error")
+		}
+		out := buf.String()
+		if !strings.Contains(out, `"lineId":"`+line.LineID+`"`) || !strings.Contains(out, `"deviceURL":"https://wdm/devices/test"`) {
+			t.Errorf("Expected lineId and deviceURL fields in logs, got %s", out)
+		}
+	})
+
 	t.Run("Register failure falls back to backup", func(t *testing.T) {
 		callCount := 0
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -782,6 +955,148 @@ func TestCall(t *testing.T) {
 	})
 }
 
+// spanTracer marks the contexts of the spans it starts.
+type spanTracer struct{}
+
+type spanKey struct{}
+
+func (spanTracer) Start(ctx context.Context, name string, attrs ...webexsdk.Attribute) (context.Context, webexsdk.Span) {
+	return context.WithValue(ctx, spanKey{}, name), noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...webexsdk.Attribute) {}
+func (noopSpan) RecordError(err error)                     {}
+func (noopSpan) End()                                      {}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestCallDialPropagatesSpan(t *testing.T) {
+	var span any
+	core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+		Tracer: spanTracer{},
+		HttpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			span = req.Context().Value(spanKey{})
+			return nil, errors.New("mobius unavailable")
+		})},
+	})
+	call, _ := NewCall(core, CallDirectionOutbound, &CallDetails{Type: CallTypeURI, Address: "sip:test@example.com"}, &CallConfig{
+		MobiusURL: "https://mobius.webex.com/api/v1/calling/web/",
+		DeviceID:  "dev-1",
+		LineID:    "line-1",
+	})
+	defer func() { _ = call.GetMedia().Close() }()
+
+	if err := call.Dial(); err == nil {
+		t.Fatal("Expected an error from the failing transport")
+	}
+	if span != webexsdk.SpanCallDial {
+		t.Errorf("Expected the Mobius request under the %s span, got %v", webexsdk.SpanCallDial, span)
+	}
+}
+
+type callerKey struct{}
+
+func TestCtxVariantsUseCallerContext(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]any{}
+	core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+		HttpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			seen[req.Method] = req.Context().Value(callerKey{})
+			mu.Unlock()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"callId":"call-1"}`)),
+				Request:    req,
+			}, nil
+		})},
+	})
+	ctx := context.WithValue(context.Background(), callerKey{}, "caller")
+
+	call, _ := NewCall(core, CallDirectionOutbound, &CallDetails{Type: CallTypeURI, Address: "sip:test@example.com"}, &CallConfig{
+		MobiusURL: "https://mobius.webex.com/api/v1/calling/web/",
+		DeviceID:  "dev-1",
+		LineID:    "line-1",
+	})
+	if err := call.DialCtx(ctx); err != nil {
+		t.Fatalf("DialCtx failed: %v", err)
+	}
+	if err := call.EndCtx(ctx); err != nil {
+		t.Fatalf("EndCtx failed: %v", err)
+	}
+
+	line := NewLine(core, nil, &LineConfig{PrimaryMobiusURLs: []string{"https://mobius.webex.com/api/v1/calling/web/"}})
+	defer line.stopKeepalive()
+	if err := line.RegisterCtx(ctx); err != nil {
+		t.Fatalf("RegisterCtx failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		if seen[method] != "caller" {
+			t.Errorf("Expected the %s request to carry the caller's context, got %v", method, seen[method])
+		}
+	}
+}
+
+func TestCallDialCtxHonorsCancellation(t *testing.T) {
+	core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+		HttpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, req.Context().Err()
+		})},
+	})
+	call, _ := NewCall(core, CallDirectionOutbound, &CallDetails{Type: CallTypeURI, Address: "sip:test@example.com"}, &CallConfig{
+		MobiusURL: "https://mobius.webex.com/api/v1/calling/web/",
+		DeviceID:  "dev-1",
+		LineID:    "line-1",
+	})
+	defer func() { _ = call.GetMedia().Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := call.DialCtx(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if call.GetState() != CallStateDisconnected {
+		t.Errorf("Expected disconnected state, got %s", call.GetState())
+	}
+}
+
+func TestDiscoverMobiusServersFallback(t *testing.T) {
+	for _, env := range []*webexsdk.Environment{webexsdk.Commercial, webexsdk.FedRAMP} {
+		t.Run(env.Name, func(t *testing.T) {
+			var mu sync.Mutex
+			var hosts []string
+			core, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+				Environment: env,
+				HttpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					mu.Lock()
+					hosts = append(hosts, req.URL.Hostname())
+					mu.Unlock()
+					return nil, errors.New("unreachable")
+				})},
+			})
+			cc := NewCallingClient(core, nil, nil)
+
+			err := cc.DiscoverMobiusServers()
+			fellBack := slices.Contains(hosts, "mobius-eu-central-1.prod.infra.webex.com")
+			if env.MobiusHost == "" {
+				if err == nil || fellBack {
+					t.Errorf("Expected no Mobius fallback, got err=%v hosts=%v", err, hosts)
+				}
+			} else if !fellBack {
+				t.Errorf("Expected the fallback Mobius host to be queried, got %v", hosts)
+			}
+		})
+	}
+}
+
 // ---- CallingClient Tests ----
 
 func TestCallingClient(t *testing.T) {
@@ -8,6 +8,7 @@ package calling
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -40,6 +41,11 @@ func newCallHistoryClient(core *webexsdk.Client, config *Config) *CallHistoryCli
 //   - sort: Sort order (ASC or DESC).
 //   - sortBy: Field to sort by (endTime or startTime).
 func (c *CallHistoryClient) GetCallHistoryData(days, limit int, sort Sort, sortBy SortBy) (*CallHistoryResponse, error) {
+	return c.GetCallHistoryDataCtx(context.Background(), days, limit, sort, sortBy)
+}
+
+// GetCallHistoryDataCtx is like GetCallHistoryData but uses ctx for the request.
+func (c *CallHistoryClient) GetCallHistoryDataCtx(ctx context.Context, days, limit int, sort Sort, sortBy SortBy) (*CallHistoryResponse, error) {
 	url := fmt.Sprintf("%s/telephony/callHistory?days=%s&limit=%s&sort=%s&sortBy=%s",
 		c.baseURL,
 		strconv.Itoa(days),
@@ -48,7 +54,7 @@ func (c *CallHistoryClient) GetCallHistoryData(days, limit int, sort Sort, sortB
 		string(sortBy),
 	)
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -56,7 +62,7 @@ func (c *CallHistoryClient) GetCallHistoryData(days, limit int, sort Sort, sortB
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -98,6 +104,11 @@ func (c *CallHistoryClient) GetCallHistoryData(days, limit int, sort Sort, sortB
 // Parameters:
 //   - endTimeSessionIDs: An array of EndTimeSessionID identifying the missed call records to mark as read.
 func (c *CallHistoryClient) UpdateMissedCalls(endTimeSessionIDs []EndTimeSessionID) (*UpdateMissedCallsResponse, error) {
+	return c.UpdateMissedCallsCtx(context.Background(), endTimeSessionIDs)
+}
+
+// UpdateMissedCallsCtx is like UpdateMissedCalls but uses ctx for the request.
+func (c *CallHistoryClient) UpdateMissedCallsCtx(ctx context.Context, endTimeSessionIDs []EndTimeSessionID) (*UpdateMissedCallsResponse, error) {
 	url := fmt.Sprintf("%s/telephony/callHistory/missedCalls", c.baseURL)
 
 	payload := struct {
@@ -111,7 +122,7 @@ func (c *CallHistoryClient) UpdateMissedCalls(endTimeSessionIDs []EndTimeSession
 		return nil, fmt.Errorf("error marshaling payload: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -119,7 +130,7 @@ func (c *CallHistoryClient) UpdateMissedCalls(endTimeSessionIDs []EndTimeSession
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -155,6 +166,11 @@ func (c *CallHistoryClient) UpdateMissedCalls(endTimeSessionIDs []EndTimeSession
 // Parameters:
 //   - deleteSessionIDs: An array of EndTimeSessionID identifying the records to delete.
 func (c *CallHistoryClient) DeleteCallHistoryRecords(deleteSessionIDs []EndTimeSessionID) (*DeleteCallHistoryResponse, error) {
+	return c.DeleteCallHistoryRecordsCtx(context.Background(), deleteSessionIDs)
+}
+
+// DeleteCallHistoryRecordsCtx is like DeleteCallHistoryRecords but uses ctx for the request.
+func (c *CallHistoryClient) DeleteCallHistoryRecordsCtx(ctx context.Context, deleteSessionIDs []EndTimeSessionID) (*DeleteCallHistoryResponse, error) {
 	url := fmt.Sprintf("%s/telephony/callHistory/delete", c.baseURL)
 
 	payload := struct {
@@ -168,7 +184,7 @@ func (c *CallHistoryClient) DeleteCallHistoryRecords(deleteSessionIDs []EndTimeS
 		return nil, fmt.Errorf("error marshaling payload: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -176,7 +192,7 @@ func (c *CallHistoryClient) DeleteCallHistoryRecords(deleteSessionIDs []EndTimeS
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -27,7 +27,7 @@ type Client struct {
 // New creates a new Calling client.
 func New(core *webexsdk.Client, config *Config) *Client {
 	if config == nil {
-		config = DefaultConfig()
+		config = defaultConfigFor(core)
 	}
 
 	return &Client{
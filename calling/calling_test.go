@@ -7,7 +7,9 @@
 package calling
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -895,3 +897,21 @@ func TestTypeConstants(t *testing.T) {
 		t.Errorf("Expected SPARK, got %q", SessionTypeSpark)
 	}
 }
+
+func TestVoicemailGetListCtxCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request with a canceled context")
+	}))
+	defer server.Close()
+
+	core, _ := webexsdk.NewClient("test-token", nil)
+	vm := newVoicemailClient(core, &Config{BaseURL: server.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := vm.GetVoicemailListCtx(ctx, 0, 10, SortDESC)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -8,10 +8,11 @@ package calling
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"strings"
 	"sync"
@@ -60,6 +61,9 @@ type CallingClient struct {
 
 	// Events
 	Emitter *EventEmitter
+
+	// Structured logger
+	logger *slog.Logger
 }
 
 // CallingClientConfig holds configuration for the CallingClient
@@ -77,7 +81,7 @@ type CallingClientConfig struct {
 // NewCallingClient creates a new CallingClient for managing lines and calls
 func NewCallingClient(core *webexsdk.Client, config *Config, clientConfig *CallingClientConfig) *CallingClient {
 	if config == nil {
-		config = DefaultConfig()
+		config = defaultConfigFor(core)
 	}
 
 	cc := &CallingClient{
@@ -87,6 +91,7 @@ func NewCallingClient(core *webexsdk.Client, config *Config, clientConfig *Calli
 		activeCalls: make(map[string]*Call),
 		Emitter:     NewEventEmitter(),
 		mediaConfig: DefaultMediaConfig(),
+		logger:      core.Slog().With("component", "calling"),
 	}
 
 	if clientConfig != nil {
@@ -108,17 +113,19 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 	// Step 1: Register WDM device to get clientDeviceUri and Mobius hosts
 	mobiusHosts, err := cc.registerWDMDevice()
 	if err != nil {
-		log.Printf("WDM device registration failed: %v", err)
+		cc.logger.Warn("WDM device registration failed", "error", err)
 	}
 	if len(mobiusHosts) == 0 {
-		// Fallback: use the well-known EU Mobius host (which resolves)
-		mobiusHosts = []string{"mobius-eu-central-1.prod.infra.webex.com"}
+		// Fall back to the environment's well-known Mobius host, if it has one
+		if fallback := cc.core.Environment().MobiusHost; fallback != "" {
+			mobiusHosts = []string{fallback}
+		}
 	}
 
 	// Step 2: Get region info
 	regionInfo, err := cc.getRegionInfo()
 	if err != nil {
-		log.Printf("Region discovery failed: %v, will query Mobius without region", err)
+		cc.logger.Warn("region discovery failed, querying Mobius without region", "error", err)
 	}
 
 	// Step 3: Query Mobius discovery endpoint for primary/backup URIs
@@ -135,21 +142,21 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 		if err != nil {
 			continue
 		}
-		trackingID := fmt.Sprintf("webex-go-sdk_%s", uuid.New().String())
+		trackingID := cc.core.TrackingID(req.Context())
 		req.Header.Set("Authorization", "Bearer "+cc.core.GetAccessToken())
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("spark-user-agent", "webex-calling/beta")
-		req.Header.Set("trackingid", trackingID)
+		req.Header.Set(webexsdk.TrackingIDHeader, trackingID)
 		if cc.clientDeviceURI != "" {
 			req.Header.Set("cisco-device-url", cc.clientDeviceURI)
 		}
 
-		log.Printf("Mobius discovery request: GET %s (cisco-device-url=%s, trackingId=%s)", discoveryURL, cc.clientDeviceURI, trackingID)
+		cc.logger.Debug("Mobius discovery request", "url", discoveryURL, "deviceURL", cc.clientDeviceURI, "trackingId", trackingID)
 
-		resp, err := cc.core.GetHTTPClient().Do(req)
+		resp, err := cc.core.Do(req)
 		if err != nil {
-			log.Printf("Mobius discovery failed for host %s: %v", host, err)
+			cc.logger.Warn("Mobius discovery failed", "host", host, "trackingId", trackingID, "error", err)
 			continue
 		}
 
@@ -157,11 +164,11 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 		_ = resp.Body.Close()
 
 		if resp.StatusCode != http.StatusOK {
-			log.Printf("Mobius discovery returned %d for host %s: %s", resp.StatusCode, host, string(body))
+			cc.logger.Warn("Mobius discovery returned error status", "host", host, "status", resp.StatusCode, "trackingId", trackingID, "body", string(body))
 			continue
 		}
 
-		log.Printf("Mobius discovery raw response: %s", string(body))
+		cc.logger.Debug("Mobius discovery response", "trackingId", trackingID, "body", string(body))
 
 		// Parse the Mobius discovery response.
 		// Format: { "primary": { "region": "...", "uris": ["https://..."] },
@@ -177,12 +184,12 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 			} `json:"backup"`
 		}
 		if err := json.Unmarshal(body, &mobiusServers); err != nil {
-			log.Printf("Failed to parse Mobius discovery response: %v, body: %s", err, string(body))
+			cc.logger.Warn("failed to parse Mobius discovery response", "trackingId", trackingID, "body", string(body), "error", err)
 			// Use this host directly as the Mobius URL
 			cc.mu.Lock()
 			cc.primaryMobiusURLs = []string{mobiusBase + "/calling/web/"}
 			cc.mu.Unlock()
-			log.Printf("Using Mobius host directly: %s", mobiusBase)
+			cc.logger.Info("using Mobius host directly", "url", mobiusBase)
 			return nil
 		}
 
@@ -203,7 +210,7 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 		}
 		cc.mu.Unlock()
 
-		log.Printf("Discovered Mobius servers: primary=%v backup=%v", cc.primaryMobiusURLs, cc.backupMobiusURLs)
+		cc.logger.Info("discovered Mobius servers", "primary", cc.primaryMobiusURLs, "backup", cc.backupMobiusURLs)
 		return nil
 	}
 
@@ -214,7 +221,7 @@ func (cc *CallingClient) DiscoverMobiusServers() error {
 			fmt.Sprintf("https://%s/api/v1/calling/web/", mobiusHosts[0]),
 		}
 		cc.mu.Unlock()
-		log.Printf("Using fallback Mobius URL: %s", cc.primaryMobiusURLs[0])
+		cc.logger.Info("using fallback Mobius URL", "url", cc.primaryMobiusURLs[0])
 		return nil
 	}
 
@@ -232,7 +239,7 @@ type regionInfoResult struct {
 func (cc *CallingClient) getRegionInfo() (*regionInfoResult, error) {
 	url := cc.config.RegionDiscoveryURL
 	if url == "" {
-		url = "https://ds.ciscospark.com/v1/region"
+		url = cc.core.Environment().RegionDiscoveryURL
 	}
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -242,7 +249,7 @@ func (cc *CallingClient) getRegionInfo() (*regionInfoResult, error) {
 	req.Header.Set("Authorization", "Bearer "+cc.core.GetAccessToken())
 	req.Header.Set("spark-user-agent", "webex-calling/go-sdk (web)")
 
-	resp, err := cc.core.GetHTTPClient().Do(req)
+	resp, err := cc.core.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +270,7 @@ func (cc *CallingClient) getRegionInfo() (*regionInfoResult, error) {
 		info.ClientRegion = info.RegionCode
 	}
 
-	log.Printf("Region discovered: region=%s country=%s", info.ClientRegion, info.CountryCode)
+	cc.logger.Debug("region discovered", "region", info.ClientRegion, "country", info.CountryCode)
 	return &info, nil
 }
 
@@ -274,7 +281,7 @@ func (cc *CallingClient) getRegionInfo() (*regionInfoResult, error) {
 func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 	// If clientDeviceURI is already set, skip WDM registration
 	if cc.clientDeviceURI != "" {
-		log.Printf("Using provided clientDeviceUri: %s", cc.clientDeviceURI)
+		cc.logger.Debug("using provided client device URL", "deviceURL", cc.clientDeviceURI)
 		return nil, nil
 	}
 
@@ -294,7 +301,7 @@ func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 
 	baseWDMURL := cc.config.WDMURL
 	if baseWDMURL == "" {
-		baseWDMURL = "https://wdm-a.wbx2.com/wdm/api/v1/devices"
+		baseWDMURL = cc.core.Environment().WDMURL
 	}
 	wdmURL := baseWDMURL + "?includeUpstreamServices=all"
 	req, err := http.NewRequest(http.MethodPost, wdmURL, bytes.NewBuffer(payloadBytes))
@@ -306,7 +313,7 @@ func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/go-sdk (web)")
 
-	resp, err := cc.core.GetHTTPClient().Do(req)
+	resp, err := cc.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making WDM request: %w", err)
 	}
@@ -353,7 +360,7 @@ func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 			cc.wdmWebSocketURL = wdmResp.WebSocketURL
 		}
 		cc.mu.Unlock()
-		log.Printf("WDM device registered: url=%s userId=%s wsUrl=%s", wdmResp.URL, wdmResp.UserID, wdmResp.WebSocketURL)
+		cc.logger.Info("WDM device registered", "deviceURL", wdmResp.URL, "userId", wdmResp.UserID, "webSocketURL", wdmResp.WebSocketURL)
 	}
 
 	// Debug: log all hostCatalog keys to find Mobius entries
@@ -362,16 +369,16 @@ func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 		for k := range wdmResp.ServiceHostMap.HostCatalog {
 			keys = append(keys, k)
 		}
-		log.Printf("WDM serviceHostMap.hostCatalog keys (%d): %v", len(keys), keys)
+		cc.logger.Debug("WDM host catalog", "keys", keys)
 	} else {
-		log.Printf("WDM serviceHostMap.hostCatalog is empty")
+		cc.logger.Debug("WDM host catalog is empty")
 	}
 
 	// Debug: log serviceLinks
 	if len(wdmResp.ServiceHostMap.ServiceLinks) > 0 {
 		for k, v := range wdmResp.ServiceHostMap.ServiceLinks {
 			if contains(k, "mobius") || contains(k, "call") {
-				log.Printf("WDM serviceLink: %s = %s", k, v)
+				cc.logger.Debug("WDM service link", "name", k, "url", v)
 			}
 		}
 	}
@@ -444,9 +451,9 @@ func (cc *CallingClient) registerWDMDevice() ([]string, error) {
 	}
 
 	if len(mobiusHosts) > 0 {
-		log.Printf("Found %d Mobius hosts from WDM: %v", len(mobiusHosts), mobiusHosts)
+		cc.logger.Debug("found Mobius hosts from WDM", "hosts", mobiusHosts)
 	} else {
-		log.Printf("No Mobius hosts found in WDM response, will use defaults")
+		cc.logger.Debug("no Mobius hosts found in WDM response, using defaults")
 	}
 
 	return mobiusHosts, nil
@@ -490,17 +497,17 @@ func (cc *CallingClient) DeregisterAllDevices() (int, error) {
 		return 0, err
 	}
 
-	trackingID := fmt.Sprintf("webex-go-sdk_%s", uuid.New().String())
+	trackingID := cc.core.TrackingID(req.Context())
 	req.Header.Set("Authorization", "Bearer "+cc.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/beta")
-	req.Header.Set("trackingid", trackingID)
+	req.Header.Set(webexsdk.TrackingIDHeader, trackingID)
 	if deviceURI != "" {
 		req.Header.Set("cisco-device-url", deviceURI)
 	}
 
-	resp, err := cc.core.GetHTTPClient().Do(req)
+	resp, err := cc.core.Do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -517,7 +524,7 @@ func (cc *CallingClient) DeregisterAllDevices() (int, error) {
 		if json.Unmarshal(body, &errResp) == nil && errResp.ErrorCode == 101 {
 			for _, dev := range errResp.Devices {
 				delURL := fmt.Sprintf("%sdevices/%s", mobiusURL, dev.DeviceID)
-				log.Printf("Deregister all: deleting device %s", dev.DeviceID)
+				cc.logger.Debug("deleting Mobius device", "deviceId", dev.DeviceID)
 				delReq, err := http.NewRequest(http.MethodDelete, delURL, nil)
 				if err != nil {
 					continue
@@ -525,17 +532,17 @@ func (cc *CallingClient) DeregisterAllDevices() (int, error) {
 				delReq.Header.Set("Authorization", "Bearer "+cc.core.GetAccessToken())
 				delReq.Header.Set("Accept", "application/json")
 				delReq.Header.Set("spark-user-agent", "webex-calling/beta")
-				delReq.Header.Set("trackingid", fmt.Sprintf("webex-go-sdk_%s", uuid.New().String()))
+				delReq.Header.Set(webexsdk.TrackingIDHeader, cc.core.TrackingID(delReq.Context()))
 				if deviceURI != "" {
 					delReq.Header.Set("cisco-device-url", deviceURI)
 				}
-				delResp, err := cc.core.GetHTTPClient().Do(delReq)
+				delResp, err := cc.core.Do(delReq)
 				if err != nil {
-					log.Printf("Failed to delete device %s: %v", dev.DeviceID, err)
+					cc.logger.Warn("failed to delete Mobius device", "deviceId", dev.DeviceID, "error", err)
 					continue
 				}
 				_ = delResp.Body.Close()
-				log.Printf("Deleted device %s (status %d)", dev.DeviceID, delResp.StatusCode)
+				cc.logger.Debug("deleted Mobius device", "deviceId", dev.DeviceID, "status", delResp.StatusCode)
 				deleted++
 			}
 		}
@@ -551,11 +558,11 @@ func (cc *CallingClient) DeregisterAllDevices() (int, error) {
 			delReq, _ := http.NewRequest(http.MethodDelete, delURL, nil)
 			delReq.Header.Set("Authorization", "Bearer "+cc.core.GetAccessToken())
 			delReq.Header.Set("spark-user-agent", "webex-calling/beta")
-			delReq.Header.Set("trackingId", fmt.Sprintf("webex-go-sdk_%s", uuid.New().String()))
+			delReq.Header.Set(webexsdk.TrackingIDHeader, cc.core.TrackingID(delReq.Context()))
 			if deviceURI != "" {
 				delReq.Header.Set("cisco-device-url", deviceURI)
 			}
-			delResp, err := cc.core.GetHTTPClient().Do(delReq)
+			delResp, err := cc.core.Do(delReq)
 			if err == nil {
 				_ = delResp.Body.Close()
 				deleted++
@@ -563,7 +570,7 @@ func (cc *CallingClient) DeregisterAllDevices() (int, error) {
 		}
 	}
 
-	log.Printf("Deregister all: deleted %d devices", deleted)
+	cc.logger.Info("deregistered all Mobius devices", "deleted", deleted)
 	return deleted, nil
 }
 
@@ -578,6 +585,11 @@ func (cc *CallingClient) SetMobiusServers(primary, backup []string) {
 // CreateLine creates and registers a new line with the Mobius servers.
 // Returns the Line object which can be used to make and receive calls.
 func (cc *CallingClient) CreateLine() (*Line, error) {
+	return cc.CreateLineCtx(context.Background())
+}
+
+// CreateLineCtx is like CreateLine but uses ctx for the Mobius registration.
+func (cc *CallingClient) CreateLineCtx(ctx context.Context) (*Line, error) {
 	cc.mu.RLock()
 	primary := cc.primaryMobiusURLs
 	backup := cc.backupMobiusURLs
@@ -599,7 +611,7 @@ func (cc *CallingClient) CreateLine() (*Line, error) {
 		UserID:            userID,
 	})
 
-	if err := line.Register(); err != nil {
+	if err := line.RegisterCtx(ctx); err != nil {
 		return nil, fmt.Errorf("line registration failed: %w", err)
 	}
 
@@ -652,6 +664,11 @@ func (cc *CallingClient) GetLines() map[string]*Line {
 
 // MakeCall creates and dials an outbound call on the specified line
 func (cc *CallingClient) MakeCall(line *Line, destination *CallDetails) (*Call, error) {
+	return cc.MakeCallCtx(context.Background(), line, destination)
+}
+
+// MakeCallCtx is like MakeCall but uses ctx for the Mobius dial requests.
+func (cc *CallingClient) MakeCallCtx(ctx context.Context, line *Line, destination *CallDetails) (*Call, error) {
 	if line == nil {
 		return nil, fmt.Errorf("line is required")
 	}
@@ -677,7 +694,7 @@ func (cc *CallingClient) MakeCall(line *Line, destination *CallDetails) (*Call,
 	cc.activeCalls[call.GetCorrelationID()] = call
 	cc.mu.Unlock()
 
-	if err := call.Dial(); err != nil {
+	if err := call.DialCtx(ctx); err != nil {
 		cc.mu.Lock()
 		delete(cc.activeCalls, call.GetCorrelationID())
 		cc.mu.Unlock()
@@ -733,13 +750,18 @@ func (cc *CallingClient) GetWDMWebSocketURL() string {
 // This is the idiomatic way to set up Mercury for calling — replaces the
 // manual wildcard handler + event routing that consumers previously had to do.
 func (cc *CallingClient) ConnectMercury(merc *mercury.Client) error {
+	return cc.ConnectMercuryCtx(context.Background(), merc)
+}
+
+// ConnectMercuryCtx is like ConnectMercury but uses ctx for the connection attempts.
+func (cc *CallingClient) ConnectMercuryCtx(ctx context.Context, merc *mercury.Client) error {
 	// Use the same WDM device's WebSocket URL that was used for Mobius registration
 	wsURL := cc.GetWDMWebSocketURL()
 	if wsURL != "" {
-		log.Printf("CallingClient: Mercury using WDM WebSocket URL: %s", wsURL)
+		cc.logger.Debug("Mercury using WDM WebSocket URL", "webSocketURL", wsURL)
 		merc.SetCustomWebSocketURL(wsURL)
 	} else {
-		log.Println("CallingClient: WARNING - no WDM WebSocket URL, Mercury using default device")
+		cc.logger.Warn("no WDM WebSocket URL, Mercury using default device")
 	}
 
 	// Clear existing wildcard handlers to prevent duplicates on re-registration
@@ -751,23 +773,23 @@ func (cc *CallingClient) ConnectMercury(merc *mercury.Client) error {
 			return
 		}
 		eventType, _ := event.Data["eventType"].(string)
-		log.Printf("CallingClient: Mercury event: eventType=%s id=%s", eventType, event.ID)
+		cc.logger.Debug("Mercury event", "eventType", eventType, "eventId", event.ID)
 		if strings.HasPrefix(eventType, "mobius.") {
-			log.Printf("CallingClient: routing Mobius event: %s", eventType)
+			cc.logger.Debug("routing Mobius event", "eventType", eventType)
 			eventBytes, err := json.Marshal(event)
 			if err != nil {
-				log.Printf("CallingClient: failed to marshal Mercury event: %v", err)
+				cc.logger.Error("failed to marshal Mercury event", "eventId", event.ID, "error", err)
 				return
 			}
 			cc.HandleMercuryEvent(eventBytes)
 		}
 	})
 
-	log.Println("CallingClient: connecting Mercury WebSocket...")
-	if err := merc.Connect(); err != nil {
+	cc.logger.Debug("connecting Mercury WebSocket")
+	if err := merc.ConnectCtx(ctx); err != nil {
 		return fmt.Errorf("mercury connection failed: %w", err)
 	}
-	log.Println("CallingClient: Mercury WebSocket connected")
+	cc.logger.Info("Mercury WebSocket connected")
 
 	cc.mu.Lock()
 	cc.mercuryClient = merc
@@ -785,9 +807,9 @@ func (cc *CallingClient) DisconnectMercury() {
 
 	if merc != nil {
 		if err := merc.Disconnect(); err != nil {
-			log.Printf("CallingClient: Mercury disconnect error: %v", err)
+			cc.logger.Warn("Mercury disconnect error", "error", err)
 		}
-		log.Println("CallingClient: Mercury disconnected")
+		cc.logger.Info("Mercury disconnected")
 	}
 }
 
@@ -806,7 +828,7 @@ func (cc *CallingClient) SetAudioBridge(bridge *AudioBridge) {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
 	cc.audioBridge = bridge
-	log.Println("CallingClient: audio bridge set")
+	cc.logger.Debug("audio bridge set")
 }
 
 // ClearAudioBridge removes the AudioBridge from this CallingClient.
@@ -814,7 +836,7 @@ func (cc *CallingClient) ClearAudioBridge() {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
 	cc.audioBridge = nil
-	log.Println("CallingClient: audio bridge cleared")
+	cc.logger.Debug("audio bridge cleared")
 }
 
 // GetAudioBridge returns the currently registered AudioBridge, or nil.
@@ -842,13 +864,13 @@ func (cc *CallingClient) GetConnectedCall() *Call {
 func (cc *CallingClient) HandleMercuryEvent(eventData []byte) {
 	var event MobiusCallEvent
 	if err := json.Unmarshal(eventData, &event); err != nil {
-		log.Printf("Failed to parse Mobius event: %v (raw: %s)", err, string(eventData[:min(len(eventData), 200)]))
+		cc.logger.Warn("failed to parse Mobius event", "raw", string(eventData[:min(len(eventData), 200)]), "error", err)
 		return
 	}
 
 	data := event.Data
-	log.Printf("HandleMercuryEvent: eventType=%s callId=%s correlationId=%s hasMessage=%v",
-		data.EventType, data.CallID, data.CorrelationID, data.Message != nil)
+	cc.logger.Debug("Mobius event received",
+		"eventType", data.EventType, "callId", data.CallID, "correlationId", data.CorrelationID, "hasMessage", data.Message != nil)
 
 	// Route to existing call if we have one
 	cc.mu.RLock()
@@ -857,7 +879,7 @@ func (cc *CallingClient) HandleMercuryEvent(eventData []byte) {
 		matchByCorrelation := data.CorrelationID != "" && call.GetCorrelationID() == data.CorrelationID
 		if matchByCallID || matchByCorrelation {
 			cc.mu.RUnlock()
-			log.Printf("HandleMercuryEvent: routing to existing call %s", call.GetCallID())
+			cc.logger.Debug("routing Mobius event to existing call", "callId", call.GetCallID())
 			call.HandleMobiusEvent(&event)
 			return
 		}
@@ -885,7 +907,7 @@ func (cc *CallingClient) handleIncomingCall(event *MobiusCallEvent) {
 				call.GetState() != CallStateDisconnected &&
 				call.GetCorrelationID() == data.CorrelationID {
 				cc.mu.RUnlock()
-				log.Printf("Ignoring incoming call %s — matches active outbound call (self-call)", data.CallID)
+				cc.logger.Info("ignoring incoming call matching active outbound call (self-call)", "callId", data.CallID)
 				return
 			}
 		}
@@ -904,7 +926,7 @@ func (cc *CallingClient) handleIncomingCall(event *MobiusCallEvent) {
 	cc.mu.RUnlock()
 
 	if targetLine == nil {
-		log.Printf("Received incoming call for unknown device %s", data.DeviceID)
+		cc.logger.Warn("received incoming call for unknown device", "deviceId", data.DeviceID, "callId", data.CallID)
 		return
 	}
 
@@ -916,7 +938,7 @@ func (cc *CallingClient) handleIncomingCall(event *MobiusCallEvent) {
 		MediaConfig:     cc.mediaConfig,
 	})
 	if err != nil {
-		log.Printf("Failed to create incoming call: %v", err)
+		cc.logger.Error("failed to create incoming call", "callId", data.CallID, "error", err)
 		return
 	}
 
@@ -969,14 +991,14 @@ func (cc *CallingClient) Shutdown() error {
 	// End all active calls
 	for _, call := range calls {
 		if err := call.End(); err != nil {
-			log.Printf("Error ending call %s: %v", call.GetCallID(), err)
+			cc.logger.Warn("error ending call", "callId", call.GetCallID(), "error", err)
 		}
 	}
 
 	// Deregister all lines
 	for _, line := range lines {
 		if err := line.Deregister(); err != nil {
-			log.Printf("Error deregistering line %s: %v", line.LineID, err)
+			cc.logger.Warn("error deregistering line", "lineId", line.LineID, "error", err)
 		}
 	}
 
@@ -8,6 +8,7 @@ package calling
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -33,7 +34,7 @@ func newCallSettingsClient(core *webexsdk.Client, config *Config) *CallSettingsC
 }
 
 // doSettingsRequest is a helper that performs an HTTP request and returns a CallSettingResponse.
-func (c *CallSettingsClient) doSettingsRequest(method, url string, body interface{}) (*CallSettingResponse, error) {
+func (c *CallSettingsClient) doSettingsRequest(ctx context.Context, method, url string, body interface{}) (*CallSettingResponse, error) {
 	var reqBody io.Reader
 	if body != nil {
 		payloadBytes, err := json.Marshal(body)
@@ -43,7 +44,7 @@ func (c *CallSettingsClient) doSettingsRequest(method, url string, body interfac
 		reqBody = bytes.NewBuffer(payloadBytes)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -51,7 +52,7 @@ func (c *CallSettingsClient) doSettingsRequest(method, url string, body interfac
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -89,53 +90,93 @@ func (c *CallSettingsClient) doSettingsRequest(method, url string, body interfac
 
 // GetCallWaitingSetting fetches the call waiting setting for the authenticated user.
 func (c *CallSettingsClient) GetCallWaitingSetting() (*CallSettingResponse, error) {
+	return c.GetCallWaitingSettingCtx(context.Background())
+}
+
+// GetCallWaitingSettingCtx is like GetCallWaitingSetting but uses ctx for the request.
+func (c *CallSettingsClient) GetCallWaitingSettingCtx(ctx context.Context) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/callWaiting", c.baseURL)
-	return c.doSettingsRequest(http.MethodGet, url, nil)
+	return c.doSettingsRequest(ctx, http.MethodGet, url, nil)
 }
 
 // GetDoNotDisturbSetting fetches the Do Not Disturb (DND) setting.
 func (c *CallSettingsClient) GetDoNotDisturbSetting() (*CallSettingResponse, error) {
+	return c.GetDoNotDisturbSettingCtx(context.Background())
+}
+
+// GetDoNotDisturbSettingCtx is like GetDoNotDisturbSetting but uses ctx for the request.
+func (c *CallSettingsClient) GetDoNotDisturbSettingCtx(ctx context.Context) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/doNotDisturb", c.baseURL)
-	return c.doSettingsRequest(http.MethodGet, url, nil)
+	return c.doSettingsRequest(ctx, http.MethodGet, url, nil)
 }
 
 // SetDoNotDisturbSetting enables or disables Do Not Disturb.
 func (c *CallSettingsClient) SetDoNotDisturbSetting(enabled bool) (*CallSettingResponse, error) {
+	return c.SetDoNotDisturbSettingCtx(context.Background(), enabled)
+}
+
+// SetDoNotDisturbSettingCtx is like SetDoNotDisturbSetting but uses ctx for the request.
+func (c *CallSettingsClient) SetDoNotDisturbSettingCtx(ctx context.Context, enabled bool) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/doNotDisturb", c.baseURL)
 	payload := ToggleSetting{Enabled: enabled}
-	return c.doSettingsRequest(http.MethodPut, url, payload)
+	return c.doSettingsRequest(ctx, http.MethodPut, url, payload)
 }
 
 // GetCallForwardSetting fetches the call forwarding settings.
 func (c *CallSettingsClient) GetCallForwardSetting() (*CallSettingResponse, error) {
+	return c.GetCallForwardSettingCtx(context.Background())
+}
+
+// GetCallForwardSettingCtx is like GetCallForwardSetting but uses ctx for the request.
+func (c *CallSettingsClient) GetCallForwardSettingCtx(ctx context.Context) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/callForwarding", c.baseURL)
-	return c.doSettingsRequest(http.MethodGet, url, nil)
+	return c.doSettingsRequest(ctx, http.MethodGet, url, nil)
 }
 
 // SetCallForwardSetting updates the call forwarding settings.
 func (c *CallSettingsClient) SetCallForwardSetting(setting CallForwardSetting) (*CallSettingResponse, error) {
+	return c.SetCallForwardSettingCtx(context.Background(), setting)
+}
+
+// SetCallForwardSettingCtx is like SetCallForwardSetting but uses ctx for the request.
+func (c *CallSettingsClient) SetCallForwardSettingCtx(ctx context.Context, setting CallForwardSetting) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/callForwarding", c.baseURL)
-	return c.doSettingsRequest(http.MethodPut, url, setting)
+	return c.doSettingsRequest(ctx, http.MethodPut, url, setting)
 }
 
 // GetVoicemailSetting fetches the voicemail settings.
 func (c *CallSettingsClient) GetVoicemailSetting() (*CallSettingResponse, error) {
+	return c.GetVoicemailSettingCtx(context.Background())
+}
+
+// GetVoicemailSettingCtx is like GetVoicemailSetting but uses ctx for the request.
+func (c *CallSettingsClient) GetVoicemailSettingCtx(ctx context.Context) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/voicemail", c.baseURL)
-	return c.doSettingsRequest(http.MethodGet, url, nil)
+	return c.doSettingsRequest(ctx, http.MethodGet, url, nil)
 }
 
 // SetVoicemailSetting updates the voicemail settings.
 func (c *CallSettingsClient) SetVoicemailSetting(setting VoicemailSettingConfig) (*CallSettingResponse, error) {
+	return c.SetVoicemailSettingCtx(context.Background(), setting)
+}
+
+// SetVoicemailSettingCtx is like SetVoicemailSetting but uses ctx for the request.
+func (c *CallSettingsClient) SetVoicemailSettingCtx(ctx context.Context, setting VoicemailSettingConfig) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/voicemail", c.baseURL)
-	return c.doSettingsRequest(http.MethodPut, url, setting)
+	return c.doSettingsRequest(ctx, http.MethodPut, url, setting)
 }
 
 // GetCallForwardAlwaysSetting fetches the call forward always setting.
 // The optional directoryNumber parameter is only required for CCUC backends.
 func (c *CallSettingsClient) GetCallForwardAlwaysSetting(directoryNumber string) (*CallSettingResponse, error) {
+	return c.GetCallForwardAlwaysSettingCtx(context.Background(), directoryNumber)
+}
+
+// GetCallForwardAlwaysSettingCtx is like GetCallForwardAlwaysSetting but uses ctx for the request.
+func (c *CallSettingsClient) GetCallForwardAlwaysSettingCtx(ctx context.Context, directoryNumber string) (*CallSettingResponse, error) {
 	url := fmt.Sprintf("%s/people/me/features/callForwarding/always", c.baseURL)
 	if directoryNumber != "" {
 		url += "?directoryNumber=" + directoryNumber
 	}
-	return c.doSettingsRequest(http.MethodGet, url, nil)
+	return c.doSettingsRequest(ctx, http.MethodGet, url, nil)
 }
@@ -8,6 +8,7 @@ package calling
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,7 +33,7 @@ func newContactsClient(core *webexsdk.Client, config *Config) *ContactsClient {
 }
 
 // doContactsRequest is a helper that performs an HTTP request and returns a ContactResponse.
-func (c *ContactsClient) doContactsRequest(method, url string, body interface{}) (*ContactResponse, error) {
+func (c *ContactsClient) doContactsRequest(ctx context.Context, method, url string, body interface{}) (*ContactResponse, error) {
 	var reqBody io.Reader
 	if body != nil {
 		payloadBytes, err := json.Marshal(body)
@@ -42,7 +43,7 @@ func (c *ContactsClient) doContactsRequest(method, url string, body interface{})
 		reqBody = bytes.NewBuffer(payloadBytes)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -50,7 +51,7 @@ func (c *ContactsClient) doContactsRequest(method, url string, body interface{})
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -87,8 +88,13 @@ func (c *ContactsClient) doContactsRequest(method, url string, body interface{})
 
 // GetContacts fetches the list of contacts and contact groups for the authenticated user.
 func (c *ContactsClient) GetContacts() (*ContactResponse, error) {
+	return c.GetContactsCtx(context.Background())
+}
+
+// GetContactsCtx is like GetContacts but uses ctx for the request.
+func (c *ContactsClient) GetContactsCtx(ctx context.Context) (*ContactResponse, error) {
 	url := fmt.Sprintf("%s/people/me/contacts", c.baseURL)
-	return c.doContactsRequest(http.MethodGet, url, nil)
+	return c.doContactsRequest(ctx, http.MethodGet, url, nil)
 }
 
 // CreateContactGroup creates a new contact group with the given display name.
@@ -98,6 +104,11 @@ func (c *ContactsClient) GetContacts() (*ContactResponse, error) {
 //   - encryptionKeyURL: Optional encryption key URL.
 //   - groupType: The type of group (NORMAL or EXTERNAL).
 func (c *ContactsClient) CreateContactGroup(displayName, encryptionKeyURL string, groupType GroupType) (*ContactResponse, error) {
+	return c.CreateContactGroupCtx(context.Background(), displayName, encryptionKeyURL, groupType)
+}
+
+// CreateContactGroupCtx is like CreateContactGroup but uses ctx for the request.
+func (c *ContactsClient) CreateContactGroupCtx(ctx context.Context, displayName, encryptionKeyURL string, groupType GroupType) (*ContactResponse, error) {
 	url := fmt.Sprintf("%s/people/me/contactGroups", c.baseURL)
 
 	payload := struct {
@@ -110,23 +121,38 @@ func (c *ContactsClient) CreateContactGroup(displayName, encryptionKeyURL string
 		GroupType:        groupType,
 	}
 
-	return c.doContactsRequest(http.MethodPost, url, payload)
+	return c.doContactsRequest(ctx, http.MethodPost, url, payload)
 }
 
 // DeleteContactGroup deletes a contact group by its groupId.
 func (c *ContactsClient) DeleteContactGroup(groupID string) (*ContactResponse, error) {
+	return c.DeleteContactGroupCtx(context.Background(), groupID)
+}
+
+// DeleteContactGroupCtx is like DeleteContactGroup but uses ctx for the request.
+func (c *ContactsClient) DeleteContactGroupCtx(ctx context.Context, groupID string) (*ContactResponse, error) {
 	url := fmt.Sprintf("%s/people/me/contactGroups/%s", c.baseURL, groupID)
-	return c.doContactsRequest(http.MethodDelete, url, nil)
+	return c.doContactsRequest(ctx, http.MethodDelete, url, nil)
 }
 
 // CreateContact creates a new contact.
 func (c *ContactsClient) CreateContact(contact Contact) (*ContactResponse, error) {
+	return c.CreateContactCtx(context.Background(), contact)
+}
+
+// CreateContactCtx is like CreateContact but uses ctx for the request.
+func (c *ContactsClient) CreateContactCtx(ctx context.Context, contact Contact) (*ContactResponse, error) {
 	url := fmt.Sprintf("%s/people/me/contacts", c.baseURL)
-	return c.doContactsRequest(http.MethodPost, url, contact)
+	return c.doContactsRequest(ctx, http.MethodPost, url, contact)
 }
 
 // DeleteContact deletes a contact by its contactId.
 func (c *ContactsClient) DeleteContact(contactID string) (*ContactResponse, error) {
+	return c.DeleteContactCtx(context.Background(), contactID)
+}
+
+// DeleteContactCtx is like DeleteContact but uses ctx for the request.
+func (c *ContactsClient) DeleteContactCtx(ctx context.Context, contactID string) (*ContactResponse, error) {
 	url := fmt.Sprintf("%s/people/me/contacts/%s", c.baseURL, contactID)
-	return c.doContactsRequest(http.MethodDelete, url, nil)
+	return c.doContactsRequest(ctx, http.MethodDelete, url, nil)
 }
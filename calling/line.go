@@ -12,7 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
@@ -53,6 +53,9 @@ type Line struct {
 
 	// Events
 	Emitter *EventEmitter
+
+	// Structured logger carrying the lineId
+	logger *slog.Logger
 }
 
 // LineConfig holds configuration for creating a line
@@ -70,7 +73,7 @@ type LineConfig struct {
 // NewLine creates a new Line instance
 func NewLine(core *webexsdk.Client, config *Config, lineConfig *LineConfig) *Line {
 	if config == nil {
-		config = DefaultConfig()
+		config = defaultConfigFor(core)
 	}
 
 	l := &Line{
@@ -88,6 +91,7 @@ func NewLine(core *webexsdk.Client, config *Config, lineConfig *LineConfig) *Lin
 		l.clientDeviceURI = lineConfig.ClientDeviceURI
 		l.UserID = lineConfig.UserID
 	}
+	l.logger = core.Slog().With("component", "calling", "lineId", l.LineID, "deviceURL", l.clientDeviceURI)
 
 	return l
 }
@@ -95,6 +99,12 @@ func NewLine(core *webexsdk.Client, config *Config, lineConfig *LineConfig) *Lin
 // Register registers this line with the Mobius server.
 // It attempts primary servers first, then falls back to backup servers.
 func (l *Line) Register() error {
+	return l.RegisterCtx(context.Background())
+}
+
+// RegisterCtx is like Register but uses ctx for the Mobius requests. Each
+// attempt is additionally bounded by a 30 second timeout.
+func (l *Line) RegisterCtx(ctx context.Context) error {
 	l.mu.Lock()
 	if l.status == RegistrationStatusActive {
 		l.mu.Unlock()
@@ -105,9 +115,11 @@ func (l *Line) Register() error {
 	l.Emitter.Emit(string(LineEventConnecting), nil)
 
 	// Try primary servers first
+	var lastErr error
 	for _, url := range l.primaryMobiusURLs {
-		if err := l.attemptRegistration(url); err != nil {
-			log.Printf("Registration failed with primary %s: %v", url, err)
+		if err := l.attemptRegistration(ctx, url); err != nil {
+			l.logger.Warn("registration failed with primary Mobius", "url", url, "error", err)
+			lastErr = err
 			continue
 		}
 		l.mu.Lock()
@@ -119,8 +131,9 @@ func (l *Line) Register() error {
 
 	// Fall back to backup servers
 	for _, url := range l.backupMobiusURLs {
-		if err := l.attemptRegistration(url); err != nil {
-			log.Printf("Registration failed with backup %s: %v", url, err)
+		if err := l.attemptRegistration(ctx, url); err != nil {
+			l.logger.Warn("registration failed with backup Mobius", "url", url, "error", err)
+			lastErr = err
 			continue
 		}
 		l.mu.Lock()
@@ -135,11 +148,15 @@ func (l *Line) Register() error {
 	l.mu.Unlock()
 	l.Emitter.Emit(string(LineEventError), fmt.Errorf("registration failed with all servers"))
 
+	if lastErr != nil {
+		// Keep the last cause, e.g. webexsdk.ErrCircuitOpen, for errors.Is
+		return fmt.Errorf("registration failed with all Mobius servers: %w", lastErr)
+	}
 	return fmt.Errorf("registration failed with all Mobius servers")
 }
 
 // attemptRegistration sends a POST to register a device with a Mobius server
-func (l *Line) attemptRegistration(mobiusURL string) error {
+func (l *Line) attemptRegistration(ctx context.Context, mobiusURL string) error {
 	payload := map[string]interface{}{
 		"userId":          l.UserID,
 		"clientDeviceUri": l.clientDeviceURI,
@@ -154,33 +171,33 @@ func (l *Line) attemptRegistration(mobiusURL string) error {
 	}
 
 	url := fmt.Sprintf("%sdevice", mobiusURL)
-	log.Printf("Registration POST %s payload: %s", url, string(payloadBytes))
+	l.logger.Debug("registration request", "url", url, "payload", string(payloadBytes))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("error creating registration request: %w", err)
 	}
 
-	trackingID := fmt.Sprintf("webex-go-sdk_%s", uuid.New().String())
+	trackingID := l.core.TrackingID(req.Context())
 	req.Header.Set("Authorization", "Bearer "+l.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/beta")
-	req.Header.Set("trackingid", trackingID)
+	req.Header.Set(webexsdk.TrackingIDHeader, trackingID)
 	if l.clientDeviceURI != "" {
 		req.Header.Set("cisco-device-url", l.clientDeviceURI)
 	}
 
-	log.Printf("Registration: sending POST to %s ...", url)
-	resp, err := l.core.GetHTTPClient().Do(req)
+	l.logger.Debug("sending registration", "url", url)
+	resp, err := l.core.Do(req)
 	if err != nil {
 		return fmt.Errorf("error making registration request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
-	log.Printf("Registration: received response status %d from %s", resp.StatusCode, url)
+	l.logger.Debug("registration response", "url", url, "status", resp.StatusCode)
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -197,12 +214,12 @@ func (l *Line) attemptRegistration(mobiusURL string) error {
 			} `json:"devices"`
 		}
 		if json.Unmarshal(body, &errResp) == nil && errResp.ErrorCode == 101 && len(errResp.Devices) > 0 {
-			log.Printf("Device already registered (errorCode 101), deleting existing device %s and re-registering", errResp.Devices[0].DeviceID)
-			if delErr := l.deleteDevice(mobiusURL, errResp.Devices[0].DeviceID); delErr != nil {
-				log.Printf("Failed to delete existing device: %v", delErr)
+			l.logger.Info("device already registered, deleting existing device and re-registering", "deviceId", errResp.Devices[0].DeviceID)
+			if delErr := l.deleteDevice(ctx, mobiusURL, errResp.Devices[0].DeviceID); delErr != nil {
+				l.logger.Warn("failed to delete existing device", "error", delErr)
 			} else {
 				// Retry registration after deleting old device
-				return l.attemptRegistration(mobiusURL)
+				return l.attemptRegistration(ctx, mobiusURL)
 			}
 		}
 		return fmt.Errorf("registration failed with status %d: %s", resp.StatusCode, string(body))
@@ -244,6 +261,11 @@ type MobiusDevice struct {
 // It attempts a POST to the device endpoint and parses the 403/errorCode 101
 // response which includes the list of existing devices.
 func (l *Line) ListDevices() ([]MobiusDevice, error) {
+	return l.ListDevicesCtx(context.Background())
+}
+
+// ListDevicesCtx is like ListDevices but uses ctx for the Mobius requests.
+func (l *Line) ListDevicesCtx(ctx context.Context) ([]MobiusDevice, error) {
 	l.mu.RLock()
 	urls := append([]string{}, l.primaryMobiusURLs...)
 	urls = append(urls, l.backupMobiusURLs...)
@@ -254,9 +276,9 @@ func (l *Line) ListDevices() ([]MobiusDevice, error) {
 	}
 
 	for _, mobiusURL := range urls {
-		devices, err := l.listDevicesFromURL(mobiusURL)
+		devices, err := l.listDevicesFromURL(ctx, mobiusURL)
 		if err != nil {
-			log.Printf("ListDevices: failed from %s: %v", mobiusURL, err)
+			l.logger.Warn("listing devices failed", "url", mobiusURL, "error", err)
 			continue
 		}
 		return devices, nil
@@ -264,7 +286,7 @@ func (l *Line) ListDevices() ([]MobiusDevice, error) {
 	return nil, fmt.Errorf("failed to list devices from any Mobius server")
 }
 
-func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
+func (l *Line) listDevicesFromURL(ctx context.Context, mobiusURL string) ([]MobiusDevice, error) {
 	// A registration POST that hits a 403/101 returns the device list
 	payload := map[string]interface{}{
 		"userId":          l.UserID,
@@ -276,10 +298,10 @@ func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
 	payloadBytes, _ := json.Marshal(payload)
 
 	url := fmt.Sprintf("%sdevice", mobiusURL)
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	reqCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -287,12 +309,12 @@ func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/beta")
-	req.Header.Set("trackingid", fmt.Sprintf("webex-go-sdk_%s", uuid.New().String()))
+	req.Header.Set(webexsdk.TrackingIDHeader, l.core.TrackingID(req.Context()))
 	if l.clientDeviceURI != "" {
 		req.Header.Set("cisco-device-url", l.clientDeviceURI)
 	}
 
-	resp, err := l.core.GetHTTPClient().Do(req)
+	resp, err := l.core.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -314,9 +336,9 @@ func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		var deviceInfo MobiusDeviceInfo
 		if json.Unmarshal(body, &deviceInfo) == nil && deviceInfo.Device != nil {
-			log.Printf("ListDevices: accidentally registered device %s — deleting it", deviceInfo.Device.DeviceID)
-			if delErr := l.deleteDevice(mobiusURL, deviceInfo.Device.DeviceID); delErr != nil {
-				log.Printf("ListDevices: failed to clean up accidental registration: %v", delErr)
+			l.logger.Info("listing devices registered a device, deleting it", "deviceId", deviceInfo.Device.DeviceID)
+			if delErr := l.deleteDevice(ctx, mobiusURL, deviceInfo.Device.DeviceID); delErr != nil {
+				l.logger.Warn("failed to clean up accidental registration", "error", delErr)
 			}
 		}
 		return []MobiusDevice{}, nil
@@ -324,7 +346,7 @@ func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
 
 	// 503 means stale registrations blocking new ones — try to parse device info
 	if resp.StatusCode == http.StatusServiceUnavailable {
-		log.Printf("ListDevices: got 503 (stale registrations), response: %s", string(body))
+		l.logger.Debug("listing devices returned 503 (stale registrations)", "body", string(body))
 		var errResp struct {
 			Devices []MobiusDevice `json:"devices"`
 		}
@@ -349,7 +371,12 @@ func (l *Line) listDevicesFromURL(mobiusURL string) ([]MobiusDevice, error) {
 
 // DeleteAllDevices removes all registered Mobius devices across all known servers.
 func (l *Line) DeleteAllDevices() (int, error) {
-	devices, err := l.ListDevices()
+	return l.DeleteAllDevicesCtx(context.Background())
+}
+
+// DeleteAllDevicesCtx is like DeleteAllDevices but uses ctx for the Mobius requests.
+func (l *Line) DeleteAllDevicesCtx(ctx context.Context) (int, error) {
+	devices, err := l.ListDevicesCtx(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -362,7 +389,7 @@ func (l *Line) DeleteAllDevices() (int, error) {
 	deleted := 0
 	for _, dev := range devices {
 		for _, mobiusURL := range urls {
-			if delErr := l.deleteDevice(mobiusURL, dev.DeviceID); delErr == nil {
+			if delErr := l.deleteDevice(ctx, mobiusURL, dev.DeviceID); delErr == nil {
 				deleted++
 				break
 			}
@@ -373,11 +400,11 @@ func (l *Line) DeleteAllDevices() (int, error) {
 
 // deleteDevice deletes an existing Mobius device registration by deviceId.
 // This is used when a 403 errorCode 101 is received (device already registered).
-func (l *Line) deleteDevice(mobiusURL string, deviceID string) error {
+func (l *Line) deleteDevice(ctx context.Context, mobiusURL string, deviceID string) error {
 	url := fmt.Sprintf("%sdevices/%s", mobiusURL, deviceID)
-	log.Printf("Deleting existing Mobius device: DELETE %s", url)
+	l.logger.Debug("deleting Mobius device", "url", url)
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating delete request: %w", err)
 	}
@@ -385,12 +412,12 @@ func (l *Line) deleteDevice(mobiusURL string, deviceID string) error {
 	req.Header.Set("Authorization", "Bearer "+l.core.GetAccessToken())
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/beta")
-	req.Header.Set("trackingid", fmt.Sprintf("webex-go-sdk_%s", uuid.New().String()))
+	req.Header.Set(webexsdk.TrackingIDHeader, l.core.TrackingID(req.Context()))
 	if l.clientDeviceURI != "" {
 		req.Header.Set("cisco-device-url", l.clientDeviceURI)
 	}
 
-	resp, err := l.core.GetHTTPClient().Do(req)
+	resp, err := l.core.Do(req)
 	if err != nil {
 		return fmt.Errorf("error making delete request: %w", err)
 	}
@@ -398,7 +425,7 @@ func (l *Line) deleteDevice(mobiusURL string, deviceID string) error {
 
 	body, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		log.Printf("Successfully deleted existing device %s", deviceID)
+		l.logger.Info("deleted Mobius device", "deviceId", deviceID)
 		return nil
 	}
 
@@ -417,6 +444,11 @@ func (l *Line) onRegistered() {
 
 // Deregister deregisters this line from the Mobius server
 func (l *Line) Deregister() error {
+	return l.DeregisterCtx(context.Background())
+}
+
+// DeregisterCtx is like Deregister but uses ctx for the Mobius request.
+func (l *Line) DeregisterCtx(ctx context.Context) error {
 	l.mu.RLock()
 	mobiusURL := l.activeMobiusURL
 	deviceID := l.MobiusDeviceID
@@ -432,7 +464,7 @@ func (l *Line) Deregister() error {
 	}
 
 	url := fmt.Sprintf("%sdevices/%s", mobiusURL, deviceID)
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating deregister request: %w", err)
 	}
@@ -442,11 +474,11 @@ func (l *Line) Deregister() error {
 	if l.clientDeviceURI != "" {
 		req.Header.Set("cisco-device-url", l.clientDeviceURI)
 	}
-	req.Header.Set("trackingId", fmt.Sprintf("webex-web-client_%s", uuid.New().String()))
+	req.Header.Set(webexsdk.TrackingIDHeader, l.core.TrackingID(req.Context()))
 
-	resp, err := l.core.GetHTTPClient().Do(req)
+	resp, err := l.core.Do(req)
 	if err != nil {
-		log.Printf("Deregister request failed: %v", err)
+		l.logger.Warn("deregister request failed", "error", err)
 	} else {
 		_ = resp.Body.Close()
 	}
@@ -562,7 +594,7 @@ func (l *Line) sendKeepalive() {
 
 	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
-		log.Printf("Error creating keepalive request: %v", err)
+		l.logger.Error("error creating keepalive request", "error", err)
 		return
 	}
 
@@ -570,15 +602,15 @@ func (l *Line) sendKeepalive() {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("spark-user-agent", "webex-calling/beta")
 
-	resp, err := l.core.GetHTTPClient().Do(req)
+	resp, err := l.core.Do(req)
 	if err != nil {
-		log.Printf("Keepalive request failed: %v", err)
+		l.logger.Warn("keepalive request failed", "error", err)
 		return
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode == http.StatusNotFound {
-		log.Printf("Keepalive returned 404, device may have been deregistered")
+		l.logger.Warn("keepalive returned 404, device may have been deregistered")
 		l.mu.Lock()
 		l.status = RegistrationStatusInactive
 		l.mu.Unlock()
@@ -8,7 +8,7 @@ package calling
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 	"sync"
 
@@ -32,6 +32,7 @@ type MediaEngine struct {
 	onICECandidate func(candidate *webrtc.ICECandidate)
 	api            *webrtc.API
 	connectedCh    chan struct{} // closed when PC reaches connected state
+	logger         *slog.Logger
 }
 
 // MediaConfig holds configuration for the media engine
@@ -40,6 +41,9 @@ type MediaConfig struct {
 	ICEServers []webrtc.ICEServer
 	// AudioCodecs is the list of audio codecs to use (default: opus, PCMU, PCMA)
 	AudioCodecs []string
+	// Logger receives the engine's structured logs. If nil, slog.Default()
+	// is used. NewCall sets it to the call's logger when unset.
+	Logger *slog.Logger
 }
 
 // DefaultMediaConfig returns a MediaConfig with sensible defaults.
@@ -112,16 +116,22 @@ func NewMediaEngine(config *MediaConfig) (*MediaEngine, error) {
 		return nil, fmt.Errorf("failed to create peer connection: %w", err)
 	}
 
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
 	engine := &MediaEngine{
 		peerConnection: pc,
 		api:            api,
 		connectedCh:    make(chan struct{}),
+		logger:         logger.With("component", "media"),
 	}
 
 	// Set up ICE candidate handler
 	pc.OnICECandidate(func(c *webrtc.ICECandidate) {
 		if c != nil {
-			log.Printf("Mobius PC: ICE candidate gathered: %s", c.String())
+			engine.logger.Debug("ICE candidate gathered", "candidate", c.String())
 			if engine.onICECandidate != nil {
 				engine.onICECandidate(c)
 			}
@@ -130,7 +140,7 @@ func NewMediaEngine(config *MediaConfig) (*MediaEngine, error) {
 
 	// Log connection state changes and signal when connected
 	pc.OnConnectionStateChange(func(s webrtc.PeerConnectionState) {
-		log.Printf("Mobius PC: connection state → %s", s.String())
+		engine.logger.Info("peer connection state changed", "state", s.String())
 		if s == webrtc.PeerConnectionStateConnected {
 			engine.mu.Lock()
 			select {
@@ -142,12 +152,12 @@ func NewMediaEngine(config *MediaConfig) (*MediaEngine, error) {
 		}
 	})
 	pc.OnICEConnectionStateChange(func(s webrtc.ICEConnectionState) {
-		log.Printf("Mobius PC: ICE connection state → %s", s.String())
+		engine.logger.Debug("ICE connection state changed", "state", s.String())
 	})
 
 	// Set up remote track handler
 	pc.OnTrack(func(track *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) {
-		log.Printf("Mobius PC: OnTrack fired! codec=%s ssrc=%d", track.Codec().MimeType, track.SSRC())
+		engine.logger.Info("remote track received", "codec", track.Codec().MimeType, "ssrc", track.SSRC())
 		engine.mu.Lock()
 		engine.remoteTrack = track
 		handler := engine.onRemoteTrack
@@ -171,7 +181,7 @@ func (me *MediaEngine) OnRemoteTrack(handler func(track *webrtc.TrackRemote)) {
 	me.mu.Unlock()
 
 	if existingTrack != nil && handler != nil {
-		log.Printf("OnRemoteTrack: track already available, calling handler immediately")
+		me.logger.Debug("remote track already available, calling handler immediately")
 		handler(existingTrack)
 	}
 }
@@ -313,7 +323,7 @@ func (me *MediaEngine) SetRemoteAnswer(sdp string) error {
 	// Guard against duplicate answers (Mercury may deliver the same ROAP answer
 	// more than once due to reconnection or duplicate event delivery).
 	if me.peerConnection.SignalingState() == webrtc.SignalingStateStable {
-		log.Printf("Mobius PC: ignoring duplicate SDP answer (signaling state already stable)")
+		me.logger.Debug("ignoring duplicate SDP answer, signaling state already stable")
 		return nil
 	}
 
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
 )
 
 // ---- Enums / Constants ----
@@ -461,11 +463,25 @@ type Config struct {
 // DefaultConfig returns a Config with sensible defaults
 func DefaultConfig() *Config {
 	return &Config{
-		BaseURL:            "https://webexapis.com/v1",
+		BaseURL:            webexsdk.Commercial.APIBaseURL,
 		RequestTimeout:     30 * time.Second,
-		WDMURL:             "https://wdm-a.wbx2.com/wdm/api/v1/devices",
-		RegionDiscoveryURL: "https://ds.ciscospark.com/v1/region",
+		WDMURL:             webexsdk.Commercial.WDMURL,
+		RegionDiscoveryURL: webexsdk.Commercial.RegionDiscoveryURL,
+	}
+}
+
+// defaultConfigFor returns the default Config for core, with its BaseURL
+// and the endpoints of its Environment.
+func defaultConfigFor(core *webexsdk.Client) *Config {
+	config := DefaultConfig()
+	if core == nil {
+		return config
 	}
+	env := core.Environment()
+	config.BaseURL = core.BaseURL.String()
+	config.WDMURL = env.WDMURL
+	config.RegionDiscoveryURL = env.RegionDiscoveryURL
+	return config
 }
 
 // NormalizeAddress normalizes a dial address for Mobius/BroadWorks:
@@ -7,6 +7,7 @@
 package calling
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,8 +33,8 @@ func newVoicemailClient(core *webexsdk.Client, config *Config) *VoicemailClient
 }
 
 // doRequest is a helper that performs an HTTP request and returns the response body and status code.
-func (c *VoicemailClient) doRequest(method, url string) ([]byte, int, error) {
-	req, err := http.NewRequest(method, url, nil)
+func (c *VoicemailClient) doRequest(ctx context.Context, method, url string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error creating request: %w", err)
 	}
@@ -41,7 +42,7 @@ func (c *VoicemailClient) doRequest(method, url string) ([]byte, int, error) {
 	req.Header.Set("Authorization", "Bearer "+c.core.GetAccessToken())
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.core.GetHTTPClient().Do(req)
+	resp, err := c.core.Do(req)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error making request: %w", err)
 	}
@@ -62,6 +63,11 @@ func (c *VoicemailClient) doRequest(method, url string) ([]byte, int, error) {
 //   - offsetLimit: Maximum number of voicemails to retrieve.
 //   - sort: Sort order (ASC or DESC).
 func (c *VoicemailClient) GetVoicemailList(offset, offsetLimit int, sort Sort) (*VoicemailResponse, error) {
+	return c.GetVoicemailListCtx(context.Background(), offset, offsetLimit, sort)
+}
+
+// GetVoicemailListCtx is like GetVoicemailList but uses ctx for the request.
+func (c *VoicemailClient) GetVoicemailListCtx(ctx context.Context, offset, offsetLimit int, sort Sort) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages?offset=%s&limit=%s&sort=%s",
 		c.baseURL,
 		strconv.Itoa(offset),
@@ -69,7 +75,7 @@ func (c *VoicemailClient) GetVoicemailList(offset, offsetLimit int, sort Sort) (
 		string(sort),
 	)
 
-	body, statusCode, err := c.doRequest(http.MethodGet, url)
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, url)
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +108,14 @@ func (c *VoicemailClient) GetVoicemailList(offset, offsetLimit int, sort Sort) (
 
 // GetVoicemailContent retrieves the content of a voicemail message by its messageId.
 func (c *VoicemailClient) GetVoicemailContent(messageID string) (*VoicemailResponse, error) {
+	return c.GetVoicemailContentCtx(context.Background(), messageID)
+}
+
+// GetVoicemailContentCtx is like GetVoicemailContent but uses ctx for the request.
+func (c *VoicemailClient) GetVoicemailContentCtx(ctx context.Context, messageID string) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/%s/content", c.baseURL, messageID)
 
-	body, statusCode, err := c.doRequest(http.MethodGet, url)
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, url)
 	if err != nil {
 		return nil, err
 	}
@@ -135,9 +146,14 @@ func (c *VoicemailClient) GetVoicemailContent(messageID string) (*VoicemailRespo
 
 // GetVoicemailSummary retrieves a quantitative summary of voicemails for the user.
 func (c *VoicemailClient) GetVoicemailSummary() (*VoicemailResponse, error) {
+	return c.GetVoicemailSummaryCtx(context.Background())
+}
+
+// GetVoicemailSummaryCtx is like GetVoicemailSummary but uses ctx for the request.
+func (c *VoicemailClient) GetVoicemailSummaryCtx(ctx context.Context) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/summary", c.baseURL)
 
-	body, statusCode, err := c.doRequest(http.MethodGet, url)
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, url)
 	if err != nil {
 		return nil, err
 	}
@@ -168,9 +184,14 @@ func (c *VoicemailClient) GetVoicemailSummary() (*VoicemailResponse, error) {
 
 // MarkAsRead marks a voicemail message as read.
 func (c *VoicemailClient) MarkAsRead(messageID string) (*VoicemailResponse, error) {
+	return c.MarkAsReadCtx(context.Background(), messageID)
+}
+
+// MarkAsReadCtx is like MarkAsRead but uses ctx for the request.
+func (c *VoicemailClient) MarkAsReadCtx(ctx context.Context, messageID string) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/%s/markAsRead", c.baseURL, messageID)
 
-	_, statusCode, err := c.doRequest(http.MethodPost, url)
+	_, statusCode, err := c.doRequest(ctx, http.MethodPost, url)
 	if err != nil {
 		return nil, err
 	}
@@ -187,9 +208,14 @@ func (c *VoicemailClient) MarkAsRead(messageID string) (*VoicemailResponse, erro
 
 // MarkAsUnread marks a voicemail message as unread.
 func (c *VoicemailClient) MarkAsUnread(messageID string) (*VoicemailResponse, error) {
+	return c.MarkAsUnreadCtx(context.Background(), messageID)
+}
+
+// MarkAsUnreadCtx is like MarkAsUnread but uses ctx for the request.
+func (c *VoicemailClient) MarkAsUnreadCtx(ctx context.Context, messageID string) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/%s/markAsUnread", c.baseURL, messageID)
 
-	_, statusCode, err := c.doRequest(http.MethodPost, url)
+	_, statusCode, err := c.doRequest(ctx, http.MethodPost, url)
 	if err != nil {
 		return nil, err
 	}
@@ -206,9 +232,14 @@ func (c *VoicemailClient) MarkAsUnread(messageID string) (*VoicemailResponse, er
 
 // Delete deletes a voicemail message by its messageId.
 func (c *VoicemailClient) Delete(messageID string) (*VoicemailResponse, error) {
+	return c.DeleteCtx(context.Background(), messageID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *VoicemailClient) DeleteCtx(ctx context.Context, messageID string) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/%s", c.baseURL, messageID)
 
-	_, statusCode, err := c.doRequest(http.MethodDelete, url)
+	_, statusCode, err := c.doRequest(ctx, http.MethodDelete, url)
 	if err != nil {
 		return nil, err
 	}
@@ -225,9 +256,14 @@ func (c *VoicemailClient) Delete(messageID string) (*VoicemailResponse, error) {
 
 // GetTranscript retrieves the transcript of a voicemail message.
 func (c *VoicemailClient) GetTranscript(messageID string) (*VoicemailResponse, error) {
+	return c.GetTranscriptCtx(context.Background(), messageID)
+}
+
+// GetTranscriptCtx is like GetTranscript but uses ctx for the request.
+func (c *VoicemailClient) GetTranscriptCtx(ctx context.Context, messageID string) (*VoicemailResponse, error) {
 	url := fmt.Sprintf("%s/telephony/voiceMessages/%s/transcript", c.baseURL, messageID)
 
-	body, statusCode, err := c.doRequest(http.MethodGet, url)
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, url)
 	if err != nil {
 		return nil, err
 	}
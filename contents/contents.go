@@ -7,6 +7,7 @@
 package contents
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -22,8 +23,14 @@ type FileInfo struct {
 	ContentDisposition string
 	// ContentLength is the size in bytes (-1 if unknown).
 	ContentLength int64
-	// Data is the raw file content.
+	// Data is the raw file content. It is nil for the streaming
+	// DownloadTo and DownloadToFile methods.
 	Data []byte
+	// FileName is the filename from ContentDisposition, reduced to its base
+	// name (set by DownloadTo and DownloadToFile).
+	FileName string
+	// Path is the file written by DownloadToFile.
+	Path string
 }
 
 // DownloadOptions configures file download behavior.
@@ -79,13 +86,23 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 //   - Returns *webexsdk.APIError with 428 Precondition Required if the file is unscannable.
 //     Use DownloadWithOptions with AllowUnscannable=true to download such files.
 func (c *Client) Download(contentID string) (*FileInfo, error) {
-	return c.DownloadWithOptions(contentID, nil)
+	return c.DownloadCtx(context.Background(), contentID)
+}
+
+// DownloadCtx is like Download but uses ctx for the request.
+func (c *Client) DownloadCtx(ctx context.Context, contentID string) (*FileInfo, error) {
+	return c.DownloadWithOptionsCtx(ctx, contentID, nil)
 }
 
 // DownloadWithOptions fetches a file attachment with configurable options.
 // When opts.AllowUnscannable is true, ?allow=unscannable is appended to bypass
 // the 428 Precondition Required response for unscannable (e.g., encrypted) files.
 func (c *Client) DownloadWithOptions(contentID string, opts *DownloadOptions) (*FileInfo, error) {
+	return c.DownloadWithOptionsCtx(context.Background(), contentID, opts)
+}
+
+// DownloadWithOptionsCtx is like DownloadWithOptions but uses ctx for the request.
+func (c *Client) DownloadWithOptionsCtx(ctx context.Context, contentID string, opts *DownloadOptions) (*FileInfo, error) {
 	if contentID == "" {
 		return nil, fmt.Errorf("contentID is required")
 	}
@@ -95,7 +112,7 @@ func (c *Client) DownloadWithOptions(contentID string, opts *DownloadOptions) (*
 		path += "?allow=unscannable"
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching content: %w", err)
 	}
@@ -126,13 +143,23 @@ func (c *Client) DownloadWithOptions(contentID string, opts *DownloadOptions) (*
 // Automatic retry behaviour and anti-malware semantics are identical to
 // [Download]; see its documentation for details.
 func (c *Client) DownloadFromURL(contentURL string) (*FileInfo, error) {
-	return c.DownloadFromURLWithOptions(contentURL, nil)
+	return c.DownloadFromURLCtx(context.Background(), contentURL)
+}
+
+// DownloadFromURLCtx is like DownloadFromURL but uses ctx for the request.
+func (c *Client) DownloadFromURLCtx(ctx context.Context, contentURL string) (*FileInfo, error) {
+	return c.DownloadFromURLWithOptionsCtx(ctx, contentURL, nil)
 }
 
 // DownloadFromURLWithOptions fetches a file from a full URL with configurable options.
 // When opts.AllowUnscannable is true, ?allow=unscannable is appended to bypass
 // the 428 Precondition Required response for unscannable files.
 func (c *Client) DownloadFromURLWithOptions(contentURL string, opts *DownloadOptions) (*FileInfo, error) {
+	return c.DownloadFromURLWithOptionsCtx(context.Background(), contentURL, opts)
+}
+
+// DownloadFromURLWithOptionsCtx is like DownloadFromURLWithOptions but uses ctx for the request.
+func (c *Client) DownloadFromURLWithOptionsCtx(ctx context.Context, contentURL string, opts *DownloadOptions) (*FileInfo, error) {
 	if contentURL == "" {
 		return nil, fmt.Errorf("contentURL is required")
 	}
@@ -147,7 +174,7 @@ func (c *Client) DownloadFromURLWithOptions(contentURL string, opts *DownloadOpt
 		}
 	}
 
-	resp, err := c.webexClient.RequestURL(http.MethodGet, requestURL, nil)
+	resp, err := c.webexClient.RequestURLWithRetry(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching content: %w", err)
 	}
@@ -171,6 +198,77 @@ func (c *Client) DownloadFromURLWithOptions(contentURL string, opts *DownloadOpt
 	}, nil
 }
 
+// DownloadTo streams a file attachment by its content ID to w instead of
+// holding it in memory, for files too large to buffer. It returns the file's
+// metadata with ContentLength set to the number of bytes written and Data nil.
+//
+// 423 (file being scanned) responses are retried as described for
+// [Client.Download], and other anti-malware responses are returned the same
+// way. If the connection drops mid-transfer, the download resumes from the
+// last byte written with an HTTP Range request. An error is returned if the
+// number of bytes written does not match the Content-Length.
+func (c *Client) DownloadTo(ctx context.Context, contentID string, w io.Writer) (*FileInfo, error) {
+	return c.DownloadToWithOptions(ctx, contentID, w, nil)
+}
+
+// DownloadToWithOptions is like DownloadTo with configurable options.
+func (c *Client) DownloadToWithOptions(ctx context.Context, contentID string, w io.Writer, opts *DownloadOptions) (*FileInfo, error) {
+	if contentID == "" {
+		return nil, fmt.Errorf("contentID is required")
+	}
+
+	result, err := c.webexClient.Download(ctx, c.contentURL(contentID, opts), w, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading content: %w", err)
+	}
+	return fileInfo(result), nil
+}
+
+// DownloadToFile streams a file attachment by its content ID to a file like
+// DownloadTo. If path is an existing directory, the file is saved in it
+// under the filename from the Content-Disposition header (or the content ID
+// if the server sends none); the path written is returned in FileInfo.Path.
+// The file is removed if the download fails.
+func (c *Client) DownloadToFile(ctx context.Context, contentID, path string) (*FileInfo, error) {
+	return c.DownloadToFileWithOptions(ctx, contentID, path, nil)
+}
+
+// DownloadToFileWithOptions is like DownloadToFile with configurable options.
+func (c *Client) DownloadToFileWithOptions(ctx context.Context, contentID, path string, opts *DownloadOptions) (*FileInfo, error) {
+	if contentID == "" {
+		return nil, fmt.Errorf("contentID is required")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+
+	result, err := c.webexClient.DownloadToFile(ctx, c.contentURL(contentID, opts), path, contentID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading content: %w", err)
+	}
+	return fileInfo(result), nil
+}
+
+// contentURL returns the URL of the content with contentID.
+func (c *Client) contentURL(contentID string, opts *DownloadOptions) string {
+	contentURL := c.webexClient.BaseURL.String() + "/contents/" + contentID
+	if opts != nil && opts.AllowUnscannable {
+		contentURL += "?allow=unscannable"
+	}
+	return contentURL
+}
+
+// fileInfo converts the result of a streamed download to a FileInfo.
+func fileInfo(result *webexsdk.DownloadResult) *FileInfo {
+	return &FileInfo{
+		ContentType:        result.ContentType,
+		ContentDisposition: result.ContentDisposition,
+		ContentLength:      result.Size,
+		FileName:           result.FileName,
+		Path:               result.Path,
+	}
+}
+
 // hasQueryString returns true if the URL already has query parameters.
 func hasQueryString(u string) bool {
 	for i := 0; i < len(u); i++ {
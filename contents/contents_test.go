@@ -7,10 +7,17 @@
 package contents
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -584,3 +591,181 @@ func TestDownloadWithOptions_423AutoRetry(t *testing.T) {
 		t.Errorf("Expected application/pdf, got %s", info.ContentType)
 	}
 }
+
+func TestDownloadTo_ResumesAfterInterruption(t *testing.T) {
+	fileData := []byte(strings.Repeat("0123456789", 1000))
+	half := len(fileData) / 2
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "video/mp4")
+		w.Header().Set("ETag", `"v1"`)
+		if attempts == 1 {
+			if r.Header.Get("Range") != "" {
+				t.Errorf("Expected no Range on first request, got %q", r.Header.Get("Range"))
+			}
+			w.Header().Set("Content-Disposition", `attachment; filename="../../meeting.mp4"`)
+			w.Header().Set("Content-Length", strconv.Itoa(len(fileData)))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(fileData[:half])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler) // drop the connection mid-body
+		}
+
+		if got := r.Header.Get("Range"); got != fmt.Sprintf("bytes=%d-", half) {
+			t.Errorf("Expected Range from byte %d, got %q", half, got)
+		}
+		if r.Header.Get("If-Range") != `"v1"` {
+			t.Errorf("Expected If-Range with ETag, got %q", r.Header.Get("If-Range"))
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("Expected auth header on resumed request")
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", half, len(fileData)-1, len(fileData)))
+		w.Header().Set("Content-Length", strconv.Itoa(len(fileData)-half))
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(fileData[half:])
+	}))
+	defer server.Close()
+
+	cc := newTestClient(t, server)
+	var buf bytes.Buffer
+	info, err := cc.DownloadTo(context.Background(), "content-id-123", &buf)
+	if err != nil {
+		t.Fatalf("DownloadTo failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 requests, got %d", attempts)
+	}
+	if !bytes.Equal(buf.Bytes(), fileData) {
+		t.Errorf("Data mismatch: got %d bytes", buf.Len())
+	}
+	if info.ContentLength != int64(len(fileData)) || info.Data != nil {
+		t.Errorf("Expected ContentLength %d and no Data, got %d and %d bytes", len(fileData), info.ContentLength, len(info.Data))
+	}
+	if info.FileName != "meeting.mp4" {
+		t.Errorf("Expected sanitized filename 'meeting.mp4', got %q", info.FileName)
+	}
+	if info.ContentType != "video/mp4" {
+		t.Errorf("Expected video/mp4, got %s", info.ContentType)
+	}
+}
+
+func TestDownloadTo_423AutoRetry(t *testing.T) {
+	fileData := []byte("scanned file content")
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(423)
+			_, _ = w.Write([]byte(`{"message":"scanning"}`))
+			return
+		}
+		if r.URL.Query().Get("allow") != "unscannable" {
+			t.Errorf("Expected allow=unscannable, got %q", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(fileData)
+	}))
+	defer server.Close()
+
+	cc := newTestClientWithRetries(t, server, 2)
+	var buf bytes.Buffer
+	_, err := cc.DownloadToWithOptions(context.Background(), "scan-id", &buf, &DownloadOptions{AllowUnscannable: true})
+	if err != nil {
+		t.Fatalf("DownloadToWithOptions should have succeeded: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+	if buf.String() != string(fileData) {
+		t.Errorf("Data mismatch: %q", buf.String())
+	}
+
+	// Exhausted retries return the structured 423 error
+	locked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(423)
+		_, _ = w.Write([]byte(`{"message":"scanning"}`))
+	}))
+	defer locked.Close()
+	cc = newTestClientWithRetries(t, locked, 1)
+	_, err = cc.DownloadTo(context.Background(), "scan-id", &buf)
+	var apiErr *webexsdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 423 {
+		t.Errorf("Expected 423 APIError, got %v", err)
+	}
+}
+
+func TestDownloadTo_LengthMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Always cut the body short, so every resume fails too
+		w.Header().Set("Content-Length", "100")
+		if r.Header.Get("Range") != "" {
+			w.Header().Set("Content-Range", "bytes 10-99/100")
+			w.Header().Set("Content-Length", "90")
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		_, _ = w.Write([]byte("0123456789"))
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}))
+	defer server.Close()
+
+	cc := newTestClient(t, server)
+	_, err := cc.DownloadTo(context.Background(), "content-id", &bytes.Buffer{})
+	if err == nil {
+		t.Fatal("Expected error for truncated download")
+	}
+}
+
+func TestDownloadToFile(t *testing.T) {
+	fileData := []byte("file content")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/contents/named":
+			w.Header().Set("Content-Disposition", `attachment; filename*=UTF-8''r%C3%A9sum%C3%A9.pdf`)
+		case "/contents/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		_, _ = w.Write(fileData)
+	}))
+	defer server.Close()
+
+	cc := newTestClient(t, server)
+	dir := t.TempDir()
+
+	// A directory gets the server's filename
+	info, err := cc.DownloadToFile(context.Background(), "named", dir)
+	if err != nil {
+		t.Fatalf("DownloadToFile failed: %v", err)
+	}
+	want := filepath.Join(dir, "résumé.pdf")
+	if info.Path != want {
+		t.Errorf("Expected path %s, got %s", want, info.Path)
+	}
+	if data, err := os.ReadFile(want); err != nil || !bytes.Equal(data, fileData) {
+		t.Errorf("Unexpected file content %q, %v", data, err)
+	}
+
+	// An explicit file path is used as is
+	path := filepath.Join(dir, "out.bin")
+	if _, err := cc.DownloadToFile(context.Background(), "plain", path); err != nil {
+		t.Fatalf("DownloadToFile failed: %v", err)
+	}
+	if data, _ := os.ReadFile(path); !bytes.Equal(data, fileData) {
+		t.Errorf("Unexpected file content %q", data)
+	}
+
+	// Errors leave no file behind
+	path = filepath.Join(dir, "missing.bin")
+	if _, err := cc.DownloadToFile(context.Background(), "missing", path); err == nil {
+		t.Fatal("Expected error for 404")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected no file after failed download, got %v", err)
+	}
+}
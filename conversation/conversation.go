@@ -9,12 +9,12 @@ package conversation
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"sync"
 
 	"github.com/WebexCommunity/webex-go-sdk/v2/encryption"
 	"github.com/WebexCommunity/webex-go-sdk/v2/mercury"
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 )
 
 // ActivityHandler is a function that handles conversation activities
@@ -59,6 +59,15 @@ type Activity struct {
 	RawData map[string]interface{} `json:"-"`
 }
 
+// ConversationID returns the ID of the conversation the activity belongs
+// to, or "" if the activity has no target.
+func (a *Activity) ConversationID() string {
+	if a.Target == nil {
+		return ""
+	}
+	return a.Target.ID
+}
+
 // Actor represents the person who performed the activity
 type Actor struct {
 	ID           string `json:"id,omitempty"`
@@ -107,12 +116,15 @@ type Object struct {
 
 // Config holds the configuration for the Conversation plugin
 type Config struct {
-	// Configuration options
+	// Cluster is the Hydra ID cluster used to convert activity UUIDs to
+	// REST API IDs, e.g. ids.ClusterEU for organizations whose data
+	// resides in the EU. Default: ids.ClusterUS.
+	Cluster ids.Cluster
 }
 
 // DefaultConfig returns the default configuration for the Conversation plugin
 func DefaultConfig() *Config {
-	return &Config{}
+	return &Config{Cluster: ids.ClusterUS}
 }
 
 // Client is the Conversation API client
@@ -130,6 +142,9 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 	if config == nil {
 		config = DefaultConfig()
 	}
+	if config.Cluster == "" {
+		config.Cluster = ids.ClusterUS
+	}
 
 	// Create encryption client
 	encryptionClient := encryption.New(webexClient, nil)
@@ -262,7 +277,8 @@ func (c *Client) GetMessageContent(activity *Activity) (string, error) {
 			return decryptedContent, nil
 		}
 		// Log error but continue with encrypted content
-		log.Printf("Error decrypting message content: %v", err)
+		c.webexClient.Slog().Warn("error decrypting message content",
+			"activityId", activity.ID, "conversationId", activity.ConversationID(), "error", err)
 	}
 
 	return displayName, nil
@@ -326,7 +342,7 @@ func (c *Client) SetMercuryClient(mercuryClient *mercury.Client) {
 
 		activity, err := c.ProcessActivityEvent(event)
 		if err != nil {
-			log.Printf("Error processing activity event: %v", err)
+			c.webexClient.Slog().Error("error processing activity event", "eventId", event.ID, "error", err)
 			return
 		}
 
@@ -467,7 +483,8 @@ func (c *Client) dispatchActivity(activity *Activity) {
 			// Decrypt message content inside the goroutine (Option B)
 			if isMessageActivity(activity.Verb) {
 				if err := c.processMessageContent(activity); err != nil {
-					log.Printf("Error processing message content: %v", err)
+					c.webexClient.Slog().Error("error processing message content",
+						"activityId", activity.ID, "conversationId", activity.ConversationID(), "error", err)
 				}
 			}
 			handler(activity)
@@ -493,3 +510,34 @@ func (c *Client) dispatchActivity(activity *Activity) {
 func (c *Client) InitializeFromMercuryEvent(event *mercury.Event) (*Activity, error) {
 	return c.ProcessActivityEvent(event)
 }
+
+// Cluster returns the Hydra ID cluster of the REST API IDs the client
+// converts activity UUIDs to.
+func (c *Client) Cluster() ids.Cluster {
+	return c.config.Cluster
+}
+
+// MessageID returns the REST API ID of the message posted or shared by an
+// activity, for use with the messages package.
+func (c *Client) MessageID(activity *Activity) (string, error) {
+	return ids.FromUUID(c.config.Cluster, ids.KindMessage, activity.ID)
+}
+
+// RoomID returns the REST API ID of the room (space) an activity belongs
+// to.
+func (c *Client) RoomID(activity *Activity) (string, error) {
+	conversationID := activity.ConversationID()
+	if conversationID == "" {
+		return "", fmt.Errorf("activity has no target")
+	}
+	return ids.FromUUID(c.config.Cluster, ids.KindRoom, conversationID)
+}
+
+// PersonID returns the REST API ID of the person who performed an
+// activity.
+func (c *Client) PersonID(activity *Activity) (string, error) {
+	if activity.Actor == nil || activity.Actor.ID == "" {
+		return "", fmt.Errorf("activity has no actor")
+	}
+	return ids.FromUUID(c.config.Cluster, ids.KindPeople, activity.Actor.ID)
+}
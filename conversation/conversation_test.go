@@ -12,6 +12,7 @@ import (
 
 	"github.com/WebexCommunity/webex-go-sdk/v2/mercury"
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 )
 
 func TestNew(t *testing.T) {
@@ -200,6 +201,56 @@ func TestProcessActivityEvent(t *testing.T) {
 	})
 }
 
+func TestActivityIDs(t *testing.T) {
+	client, _ := webexsdk.NewClient("test-token", nil)
+	activity := &Activity{
+		ID:     "5d2b5d10-0a4b-11ef-8f5e-6b1c2a3d4e5f",
+		Actor:  &Actor{ID: "9a8b7c6d-1234-4abc-9def-0123456789ab"},
+		Target: &Target{ID: "bbceb1ad-43f1-3b58-9147-f14bb0c4d154"},
+	}
+
+	t.Run("default cluster", func(t *testing.T) {
+		convClient := New(client, nil)
+		messageID, err := convClient.MessageID(activity)
+		if err != nil {
+			t.Fatalf("MessageID failed: %v", err)
+		}
+		if messageID != ids.New(ids.KindMessage, activity.ID).String() {
+			t.Errorf("Unexpected message ID: %s", messageID)
+		}
+		roomID, _ := convClient.RoomID(activity)
+		if err := ids.Validate(roomID, ids.KindRoom); err != nil {
+			t.Errorf("Unexpected room ID %s: %v", roomID, err)
+		}
+		personID, _ := convClient.PersonID(activity)
+		if err := ids.Validate(personID, ids.KindPeople); err != nil {
+			t.Errorf("Unexpected person ID %s: %v", personID, err)
+		}
+	})
+
+	t.Run("eu cluster", func(t *testing.T) {
+		convClient := New(client, &Config{Cluster: ids.ClusterEU})
+		roomID, err := convClient.RoomID(activity)
+		if err != nil {
+			t.Fatalf("RoomID failed: %v", err)
+		}
+		id, _ := ids.Parse(roomID)
+		if id.Cluster != ids.ClusterEU || id.UUID != activity.Target.ID {
+			t.Errorf("Unexpected room ID: %+v", id)
+		}
+	})
+
+	t.Run("missing actor and target", func(t *testing.T) {
+		convClient := New(client, nil)
+		if _, err := convClient.RoomID(&Activity{}); err == nil {
+			t.Error("Expected error without target")
+		}
+		if _, err := convClient.PersonID(&Activity{}); err == nil {
+			t.Error("Expected error without actor")
+		}
+	})
+}
+
 func TestGetMessageContent(t *testing.T) {
 	client, _ := webexsdk.NewClient("test-token", nil)
 	convClient := New(client, nil)
@@ -8,10 +8,10 @@ package device
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"sync"
 	"time"
@@ -50,7 +50,7 @@ func DefaultConfig() *Config {
 		DeviceType:         "WEB",
 		DefaultHeaders:     make(map[string]string),
 		DefaultBody:        make(map[string]interface{}),
-		WDMURL:             "https://wdm-a.wbx2.com/wdm/api/v1/devices",
+		WDMURL:             webexsdk.Commercial.WDMURL,
 	}
 }
 
@@ -89,6 +89,9 @@ type Client struct {
 func New(webexClient *webexsdk.Client, config *Config) *Client {
 	if config == nil {
 		config = DefaultConfig()
+		if webexClient != nil {
+			config.WDMURL = webexClient.Environment().WDMURL
+		}
 	}
 
 	return &Client{
@@ -100,6 +103,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Register registers a device with Webex to get a WebSocket URL
 func (c *Client) Register() error {
+	return c.RegisterCtx(context.Background())
+}
+
+// RegisterCtx is like Register but uses ctx for the request.
+func (c *Client) RegisterCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.deviceInfo != nil {
 		c.mu.Unlock()
@@ -127,9 +135,9 @@ func (c *Client) Register() error {
 	// Create the request
 	wdmURL := c.config.WDMURL
 	if wdmURL == "" {
-		wdmURL = "https://wdm-a.wbx2.com/wdm/api/v1/devices"
+		wdmURL = c.webexClient.Environment().WDMURL
 	}
-	req, err := http.NewRequest(http.MethodPost, wdmURL, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wdmURL, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
@@ -139,7 +147,7 @@ func (c *Client) Register() error {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
-	req.Header.Set("TrackingID", fmt.Sprintf("GoSDK_%d", time.Now().UnixMilli()))
+	req.Header.Set(webexsdk.TrackingIDHeader, c.webexClient.TrackingID(ctx))
 
 	// Add query parameters
 	q := req.URL.Query()
@@ -147,7 +155,7 @@ func (c *Client) Register() error {
 	req.URL.RawQuery = q.Encode()
 
 	// Send the request using the SDK's configured HTTP client
-	resp, err := c.webexClient.GetHTTPClient().Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
@@ -199,6 +207,11 @@ func (c *Client) Register() error {
 
 // Unregister unregisters a device with Webex
 func (c *Client) Unregister() error {
+	return c.UnregisterCtx(context.Background())
+}
+
+// UnregisterCtx is like Unregister but uses ctx for the request.
+func (c *Client) UnregisterCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.deviceInfo == nil || c.deviceInfo.URL == "" {
 		c.mu.Unlock()
@@ -208,7 +221,7 @@ func (c *Client) Unregister() error {
 	c.mu.Unlock()
 
 	// Create the request
-	req, err := http.NewRequest(http.MethodDelete, deviceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deviceURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
@@ -217,7 +230,7 @@ func (c *Client) Unregister() error {
 	req.Header.Set("Authorization", "Bearer "+c.webexClient.GetAccessToken())
 
 	// Send the request using the SDK's configured HTTP client
-	resp, err := c.webexClient.GetHTTPClient().Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
@@ -337,13 +350,18 @@ func (c *Client) setupRefreshTimer() {
 	c.refreshTimer = time.AfterFunc(refreshTime, func() {
 		if err := c.Refresh(); err != nil {
 			// Log error but don't stop the timer
-			log.Printf("Error refreshing device: %v", err)
+			c.webexClient.Slog().Error("error refreshing device", "deviceURL", c.GetDevice().URL, "error", err)
 		}
 	})
 }
 
 // Refresh refreshes the device registration with the Webex service
 func (c *Client) Refresh() error {
+	return c.RefreshCtx(context.Background())
+}
+
+// RefreshCtx is like Refresh but uses ctx for the request.
+func (c *Client) RefreshCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if !c.registered {
 		c.mu.Unlock()
@@ -355,7 +373,7 @@ func (c *Client) Refresh() error {
 	c.mu.Unlock()
 
 	// Build the refresh request using the full device URL directly
-	req, err := http.NewRequest(http.MethodPut, deviceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, deviceURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating refresh request: %w", err)
 	}
@@ -372,7 +390,7 @@ func (c *Client) Refresh() error {
 	}
 
 	// Make the refresh request using the SDK's configured HTTP client
-	resp, err := c.webexClient.GetHTTPClient().Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("error sending refresh request: %w", err)
 	}
@@ -49,6 +49,14 @@ func TestNew(t *testing.T) {
 			t.Error("Expected Ephemeral to be true")
 		}
 	})
+
+	t.Run("with environment", func(t *testing.T) {
+		fedClient, _ := webexsdk.NewClient("test-token", &webexsdk.Config{Environment: webexsdk.FedRAMP})
+		deviceClient := New(fedClient, nil)
+		if deviceClient.config.WDMURL != webexsdk.FedRAMP.WDMURL {
+			t.Errorf("Expected WDMURL %q, got %q", webexsdk.FedRAMP.WDMURL, deviceClient.config.WDMURL)
+		}
+	})
 }
 
 func TestDefaultConfig(t *testing.T) {
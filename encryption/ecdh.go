@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 	jose "github.com/go-jose/go-jose/v4"
 )
 
@@ -56,8 +57,8 @@ type KMSInfo struct {
 // Uses sync.Cond to avoid deadlock: the lock is released while the ECDH
 // exchange is in progress, allowing ProcessKMSMessages to run concurrently.
 // A TTL check ensures stale contexts are proactively refreshed before the
-// server rejects them.
-func (c *Client) getOrCreateECDH() (*ECDHContext, error) {
+// server rejects them. ctx bounds the exchange, if one is needed.
+func (c *Client) getOrCreateECDH(ctx context.Context) (*ECDHContext, error) {
 	c.ecdhMu.Lock()
 
 	// Wait if another goroutine is already creating the context
@@ -68,9 +69,9 @@ func (c *Client) getOrCreateECDH() (*ECDHContext, error) {
 	// Check if context was created by the other goroutine and is still fresh
 	if c.ecdhCtx != nil {
 		if time.Since(c.ecdhCtx.createdAt) < ecdhTTL {
-			ctx := c.ecdhCtx
+			ecdh := c.ecdhCtx
 			c.ecdhMu.Unlock()
-			return ctx, nil
+			return ecdh, nil
 		}
 		// Context expired — clear it so we create a fresh one
 		c.ecdhCtx = nil
@@ -81,18 +82,18 @@ func (c *Client) getOrCreateECDH() (*ECDHContext, error) {
 	c.ecdhMu.Unlock()
 
 	// Perform the exchange without holding the lock
-	ctx, err := c.performECDHExchange()
+	ecdh, err := c.performECDHExchange(ctx)
 
 	// Re-acquire lock, update state, wake waiting goroutines
 	c.ecdhMu.Lock()
 	c.ecdhCreating = false
 	if err == nil {
-		c.ecdhCtx = ctx
+		c.ecdhCtx = ecdh
 	}
 	c.ecdhCond.Broadcast()
 	c.ecdhMu.Unlock()
 
-	return ctx, err
+	return ecdh, err
 }
 
 // invalidateECDH clears the ECDH context, forcing a new exchange on next use.
@@ -111,15 +112,15 @@ func (c *Client) invalidateECDH() {
 //  4. Wait for KMS's response (sync via HTTP 200, or async via Mercury for HTTP 202)
 //  5. Derive the shared secret using raw ECDH (P-256)
 //  6. Use the 32-byte shared secret as the AES-256-GCM key for all future KMS communication
-func (c *Client) performECDHExchange() (*ECDHContext, error) {
+func (c *Client) performECDHExchange(ctx context.Context) (*ECDHContext, error) {
 	// Step 1: Get user ID (fetch from /people/me if not set)
-	userID, err := c.getUserID()
+	userID, err := c.getUserID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user ID: %w", err)
 	}
 
 	// Step 2: Get KMS info (RSA public key + cluster)
-	kmsInfo, err := c.getKMSInfo(userID)
+	kmsInfo, err := c.getKMSInfo(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get KMS info: %w", err)
 	}
@@ -137,7 +138,7 @@ func (c *Client) performECDHExchange() (*ECDHContext, error) {
 	}
 
 	// Step 5: Send ECDH request and wait for response (handles both sync and async)
-	ecdhResponse, err := c.sendECDHRequest(ecdsaPrivKey, rsaPubKey, rsaKid, kmsInfo.KMSCluster, userID)
+	ecdhResponse, err := c.sendECDHRequest(ctx, ecdsaPrivKey, rsaPubKey, rsaKid, kmsInfo.KMSCluster, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +183,7 @@ func generateECDHKeyPair() (*ecdsa.PrivateKey, *ecdh.PrivateKey, error) {
 // sendECDHRequest creates, sends the ECDH exchange request to KMS, and waits
 // for the response. The response may arrive synchronously (HTTP 200) or
 // asynchronously via Mercury WebSocket (HTTP 202).
-func (c *Client) sendECDHRequest(ecdsaPrivKey *ecdsa.PrivateKey, rsaPubKey *rsa.PublicKey, rsaKid string, cluster string, userID string) (*KMSMessage, error) {
+func (c *Client) sendECDHRequest(ctx context.Context, ecdsaPrivKey *ecdsa.PrivateKey, rsaPubKey *rsa.PublicKey, rsaKid string, cluster string, userID string) (*KMSMessage, error) {
 	requestID := generateRequestID()
 
 	// Register pending request with ECDH private key for decryption.
@@ -218,7 +219,7 @@ func (c *Client) sendECDHRequest(ecdsaPrivKey *ecdsa.PrivateKey, rsaPubKey *rsa.
 		return nil, fmt.Errorf("failed to wrap ECDH request: %w", err)
 	}
 
-	responseJWEs, err := c.sendKMSMessage(wrappedRequest, strings.TrimPrefix(cluster, "kms://"))
+	responseJWEs, err := c.sendKMSMessage(ctx, wrappedRequest, strings.TrimPrefix(cluster, "kms://"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to send ECDH request to KMS: %w", err)
 	}
@@ -238,6 +239,8 @@ func (c *Client) sendECDHRequest(ecdsaPrivKey *ecdsa.PrivateKey, rsaPubKey *rsa.
 		return &ecdhResponse, nil
 	case <-time.After(kmsResponseTimeout):
 		return nil, fmt.Errorf("timeout waiting for KMS ECDH response via Mercury")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -342,17 +345,17 @@ func extractServerECKey(response *KMSMessage) *JWK {
 }
 
 // getUserID returns the user ID, fetching from /v1/people/me if not already set.
-func (c *Client) getUserID() (string, error) {
+func (c *Client) getUserID(ctx context.Context) (string, error) {
 	if c.userID != "" {
 		return c.userID, nil
 	}
 
 	// Fetch from /v1/people/me
-	ctx, cancel := context.WithTimeout(context.Background(), c.config.HTTPTimeout)
+	ctx, cancel := context.WithTimeout(ctx, c.config.HTTPTimeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		"https://webexapis.com/v1/people/me", nil)
+		c.webexClient.BaseURL.String()+"/people/me", nil)
 	if err != nil {
 		return "", fmt.Errorf("error creating people/me request: %w", err)
 	}
@@ -360,7 +363,7 @@ func (c *Client) getUserID() (string, error) {
 	req.Header.Set("Authorization", "Bearer "+c.webexClient.GetAccessToken())
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("error fetching user info: %w", err)
 	}
@@ -391,17 +394,20 @@ func (c *Client) getUserID() (string, error) {
 }
 
 // getKMSInfo fetches KMS cluster info and RSA public key for a user.
-func (c *Client) getKMSInfo(userID string) (*KMSInfo, error) {
+func (c *Client) getKMSInfo(ctx context.Context, userID string) (*KMSInfo, error) {
 	cluster := c.config.DefaultCluster
 
 	// The KMS endpoint expects a UUID, not the base64-encoded Webex API ID.
 	// Decode if necessary (e.g., "Y2lzY29zcGFy..." -> "c488502d-...")
 	kmsUserID := decodeWebexID(userID)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.config.HTTPTimeout)
+	ctx, cancel := context.WithTimeout(ctx, c.config.HTTPTimeout)
 	defer cancel()
 
-	kmsURL := fmt.Sprintf("https://encryption-%s.wbx2.com/encryption/api/v1/kms/%s", cluster, kmsUserID)
+	kmsURL, err := c.kmsURL(cluster, kmsUserID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating KMS info request: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, kmsURL, nil)
 	if err != nil {
@@ -411,7 +417,7 @@ func (c *Client) getKMSInfo(userID string) (*KMSInfo, error) {
 	req.Header.Set("Authorization", "Bearer "+c.webexClient.GetAccessToken())
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching KMS info: %w", err)
 	}
@@ -565,34 +571,11 @@ func wrapWithRSA(payload []byte, rsaPubKey *rsa.PublicKey, kid string) (string,
 // Webex API IDs are base64url-encoded URIs like "ciscospark://us/PEOPLE/{uuid}".
 // If the input is already a UUID or unrecognizable, it is returned unchanged.
 func decodeWebexID(id string) string {
-	// If it looks like a UUID already (contains hyphens, ~36 chars), return as-is
-	if len(id) == 36 && strings.Count(id, "-") == 4 {
+	uuid, err := ids.ToUUID(id)
+	if err != nil || !ids.IsUUID(uuid) {
 		return id
 	}
-
-	// Try to decode as base64url (with or without padding)
-	decoded, err := base64.RawURLEncoding.DecodeString(id)
-	if err != nil {
-		// Try with standard base64
-		decoded, err = base64.URLEncoding.DecodeString(id)
-		if err != nil {
-			return id
-		}
-	}
-
-	// Extract UUID from the decoded URI
-	// Format: "ciscospark://us/PEOPLE/{uuid}" or "ciscospark://us/ORGANIZATION/{uuid}"
-	decodedStr := string(decoded)
-	lastSlash := strings.LastIndex(decodedStr, "/")
-	if lastSlash >= 0 && lastSlash < len(decodedStr)-1 {
-		candidate := decodedStr[lastSlash+1:]
-		// Verify it looks like a UUID
-		if len(candidate) == 36 && strings.Count(candidate, "-") == 4 {
-			return candidate
-		}
-	}
-
-	return id
+	return uuid
 }
 
 // padTo32Bytes pads or truncates a byte slice to exactly 32 bytes.
@@ -7,11 +7,11 @@
 package encryption
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -128,7 +128,6 @@ type inflightKeyRequest struct {
 type Client struct {
 	webexClient *webexsdk.Client
 	config      *Config
-	httpClient  *http.Client
 
 	// Key cache
 	mu       sync.RWMutex
@@ -155,16 +154,14 @@ type Client struct {
 func New(webexClient *webexsdk.Client, config *Config) *Client {
 	if config == nil {
 		config = DefaultConfig()
-	}
-
-	httpClient := &http.Client{
-		Timeout: config.HTTPTimeout,
+		if webexClient != nil {
+			config.DefaultCluster = webexClient.Environment().KMSCluster
+		}
 	}
 
 	c := &Client{
 		webexClient:     webexClient,
 		config:          config,
-		httpClient:      httpClient,
 		keyCache:        make(map[string]*Key),
 		inflightKeys:    make(map[string]*inflightKeyRequest),
 		pendingRequests: make(map[string]*pendingKMSRequest),
@@ -189,6 +186,13 @@ func (c *Client) SetDeviceInfo(deviceURL, userID string) {
 // shared with all waiters. This prevents thundering-herd flooding of KMS
 // when many encrypted messages arrive at once referencing the same key.
 func (c *Client) GetKey(keyURI string) (*Key, error) {
+	return c.GetKeyCtx(context.Background(), keyURI)
+}
+
+// GetKeyCtx is like GetKey but uses ctx for the KMS requests. The shared
+// round-trip runs under the ctx of the caller that started it; other callers
+// stop waiting for it when their own ctx is done.
+func (c *Client) GetKeyCtx(ctx context.Context, keyURI string) (*Key, error) {
 	// Check cache first (using read lock)
 	if !c.config.DisableCache {
 		c.mu.RLock()
@@ -209,7 +213,11 @@ func (c *Client) GetKey(keyURI string) (*Key, error) {
 	if inflight, ok := c.inflightKeys[keyURI]; ok {
 		// Another goroutine is already fetching this key — wait for it
 		c.inflightMu.Unlock()
-		<-inflight.done
+		select {
+		case <-inflight.done:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to retrieve key from KMS (shared): %w", ctx.Err())
+		}
 		if inflight.err != nil {
 			return nil, fmt.Errorf("failed to retrieve key from KMS (shared): %w", inflight.err)
 		}
@@ -230,7 +238,7 @@ func (c *Client) GetKey(keyURI string) (*Key, error) {
 	}()
 
 	// Retrieve key using KMS protocol
-	key, err := c.retrieveKeyFromKMS(keyURI)
+	key, err := c.retrieveKeyFromKMS(ctx, keyURI)
 	if err != nil {
 		inflight.err = err
 		return nil, fmt.Errorf("failed to retrieve key from KMS: %w", err)
@@ -422,6 +430,11 @@ func parseKMSURI(keyURI string) (domain string, path string, err error) {
 // The ciphertext must be in JWE compact serialization format (5 dot-separated parts).
 // Supports alg:dir + enc:A256GCM as used by Webex end-to-end encryption.
 func (c *Client) DecryptText(keyURI string, ciphertext string) (string, error) {
+	return c.DecryptTextCtx(context.Background(), keyURI, ciphertext)
+}
+
+// DecryptTextCtx is like DecryptText but uses ctx for the KMS requests.
+func (c *Client) DecryptTextCtx(ctx context.Context, keyURI string, ciphertext string) (string, error) {
 	// Parameter validation
 	if keyURI == "" {
 		return "", fmt.Errorf("key URI is required")
@@ -431,7 +444,7 @@ func (c *Client) DecryptText(keyURI string, ciphertext string) (string, error) {
 	}
 
 	// Get the key from KMS
-	key, err := c.GetKey(keyURI)
+	key, err := c.GetKeyCtx(ctx, keyURI)
 	if err != nil {
 		return "", fmt.Errorf("error getting key: %w", err)
 	}
@@ -462,6 +475,11 @@ func (c *Client) DecryptText(keyURI string, ciphertext string) (string, error) {
 
 // DecryptMessageContent attempts to decrypt message content using encryption key URL
 func (c *Client) DecryptMessageContent(encryptionKeyURL string, encryptedContent string) (string, error) {
+	return c.DecryptMessageContentCtx(context.Background(), encryptionKeyURL, encryptedContent)
+}
+
+// DecryptMessageContentCtx is like DecryptMessageContent but uses ctx for the KMS requests.
+func (c *Client) DecryptMessageContentCtx(ctx context.Context, encryptionKeyURL string, encryptedContent string) (string, error) {
 	// Parameter validation
 	if encryptionKeyURL == "" {
 		return "", fmt.Errorf("encryption key URL is required")
@@ -470,5 +488,22 @@ func (c *Client) DecryptMessageContent(encryptionKeyURL string, encryptedContent
 		return "", fmt.Errorf("encrypted content is required")
 	}
 
-	return c.DecryptText(encryptionKeyURL, encryptedContent)
+	return c.DecryptTextCtx(ctx, encryptionKeyURL, encryptedContent)
+}
+
+// kmsURL returns the URL of path on the KMS API of cluster in the client's
+// Environment, or a *webexsdk.HostNotAllowedError if the environment does
+// not allow its host.
+func (c *Client) kmsURL(cluster, path string) (string, error) {
+	env := webexsdk.Commercial
+	if c.webexClient != nil {
+		env = c.webexClient.Environment()
+	}
+	kmsURL := env.KMSURLFor(cluster) + "/" + path
+	if c.webexClient != nil {
+		if err := c.webexClient.CheckURL(kmsURL); err != nil {
+			return "", err
+		}
+	}
+	return kmsURL, nil
 }
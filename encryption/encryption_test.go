@@ -7,14 +7,19 @@
 package encryption
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -865,6 +870,144 @@ func TestGetKeyCached(t *testing.T) {
 	}
 }
 
+// spanTracer marks the contexts of the spans it starts.
+type spanTracer struct{}
+
+type spanKey struct{}
+
+func (spanTracer) Start(ctx context.Context, name string, attrs ...webexsdk.Attribute) (context.Context, webexsdk.Span) {
+	return context.WithValue(ctx, spanKey{}, name), noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...webexsdk.Attribute) {}
+func (noopSpan) RecordError(err error)                     {}
+func (noopSpan) End()                                      {}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestRetrieveKeyFromKMSPropagatesSpan(t *testing.T) {
+	webexClient, _ := webexsdk.NewClient("test-token", &webexsdk.Config{Tracer: spanTracer{}})
+	client := New(webexClient, &Config{HTTPTimeout: defaultTimeout, DefaultCluster: "a"})
+	client.userID = "user-1"
+
+	clientPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	client.ecdhCtx = &ECDHContext{
+		localPrivateKey: clientPriv,
+		sharedSecret:    make([]byte, 32),
+		kmsCluster:      "kms-a.wbx2.com",
+		createdAt:       time.Now(),
+	}
+
+	var spans []any
+	client.webexClient.GetHTTPClient().Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		spans = append(spans, req.Context().Value(spanKey{}))
+		return nil, errors.New("KMS unavailable")
+	})
+
+	if _, err := client.retrieveKeyFromKMS(context.Background(), "kms://kms-a.wbx2.com/keys/test-123"); err == nil {
+		t.Fatal("Expected an error from the failing transport")
+	}
+	if len(spans) == 0 {
+		t.Fatal("Expected a KMS request")
+	}
+	for _, span := range spans {
+		if span != webexsdk.SpanKMSFetchKey {
+			t.Errorf("Expected the request under the %s span, got %v", webexsdk.SpanKMSFetchKey, span)
+		}
+	}
+}
+
+func TestRequestsUseClientMiddleware(t *testing.T) {
+	var paths, trackingIDs []string
+	webexClient, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+		Middleware: []webexsdk.Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return webexsdk.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				paths = append(paths, req.URL.Path)
+				trackingIDs = append(trackingIDs, req.Header.Get(webexsdk.TrackingIDHeader))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":"user-1"}`)),
+					Request:    req,
+				}, nil
+			})
+		}},
+	})
+	client := New(webexClient, nil)
+
+	userID, err := client.getUserID(context.Background())
+	if err != nil {
+		t.Fatalf("getUserID failed: %v", err)
+	}
+	if _, err := client.getKMSInfo(context.Background(), userID); err != nil {
+		t.Fatalf("getKMSInfo failed: %v", err)
+	}
+	if _, err := client.sendKMSMessage(context.Background(), "{}", "kms://kms-a.wbx2.com"); err != nil {
+		t.Fatalf("sendKMSMessage failed: %v", err)
+	}
+
+	if len(paths) != 3 {
+		t.Fatalf("Expected 3 requests through the middleware, got %v", paths)
+	}
+	for i, id := range trackingIDs {
+		if id == "" {
+			t.Errorf("Expected a TrackingID on the request to %s", paths[i])
+		}
+	}
+}
+
+type callerKey struct{}
+
+func TestGetKeyCtxUsesCallerContext(t *testing.T) {
+	webexClient, _ := webexsdk.NewClient("test-token", nil)
+	client := New(webexClient, &Config{HTTPTimeout: defaultTimeout, DefaultCluster: "a"})
+	client.userID = "user-1"
+
+	clientPriv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	client.ecdhCtx = &ECDHContext{
+		localPrivateKey: clientPriv,
+		sharedSecret:    make([]byte, 32),
+		kmsCluster:      "kms-a.wbx2.com",
+		createdAt:       time.Now(),
+	}
+
+	var values []any
+	client.webexClient.GetHTTPClient().Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		values = append(values, req.Context().Value(callerKey{}))
+		return nil, errors.New("KMS unavailable")
+	})
+
+	ctx := context.WithValue(context.Background(), callerKey{}, "caller")
+	if _, err := client.GetKeyCtx(ctx, "kms://kms-a.wbx2.com/keys/test-123"); err == nil {
+		t.Fatal("Expected an error from the failing transport")
+	}
+	if len(values) == 0 {
+		t.Fatal("Expected a KMS request")
+	}
+	for _, v := range values {
+		if v != "caller" {
+			t.Errorf("Expected the KMS request to carry the caller's context, got %v", v)
+		}
+	}
+}
+
+func TestGetKeyCtxWaiterHonorsCancellation(t *testing.T) {
+	client := New(nil, nil)
+	keyURI := "kms://kms-a.wbx2.com/keys/test-123"
+
+	// Simulate a retrieval already in flight that never completes
+	client.inflightKeys[keyURI] = &inflightKeyRequest{done: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.GetKeyCtx(ctx, keyURI); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
 func TestPendingRequestMechanism(t *testing.T) {
 	// Test the async pending request registration and delivery
 	webexClient, _ := webexsdk.NewClient("test-token", nil)
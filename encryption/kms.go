@@ -18,6 +18,8 @@ import (
 	"time"
 
 	jose "github.com/go-jose/go-jose/v4"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
 )
 
 // KMSEnvelope is the HTTP request/response envelope for
@@ -51,9 +53,24 @@ type KMSCredential struct {
 
 // retrieveKeyFromKMS retrieves a key from KMS using the ECDH-based protocol.
 // The protocol is asynchronous: HTTP requests return 202, and responses
-// are delivered via Mercury WebSocket events.
-func (c *Client) retrieveKeyFromKMS(keyURI string) (*Key, error) {
-	key, err := c.retrieveKeyViaECDH(keyURI)
+// are delivered via Mercury WebSocket events. Each fetch is recorded as a
+// SpanKMSFetchKey span.
+func (c *Client) retrieveKeyFromKMS(ctx context.Context, keyURI string) (*Key, error) {
+	start := time.Now()
+	ctx, span := c.webexClient.Tracer().Start(ctx, webexsdk.SpanKMSFetchKey)
+
+	key, err := c.retrieveKeyViaECDH(ctx, keyURI)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+		span.RecordError(err)
+	}
+	span.SetAttributes(webexsdk.Attr(webexsdk.AttrOutcome, outcome))
+	span.End()
+	c.webexClient.Meter().RecordDuration(ctx, webexsdk.MetricKMSFetchDuration, time.Since(start),
+		webexsdk.Attr(webexsdk.AttrOutcome, outcome))
+
 	if err != nil {
 		return nil, fmt.Errorf("ECDH retrieval: %w", err)
 	}
@@ -85,24 +102,24 @@ func isECDHSessionError(err error) bool {
 // On failure it classifies the error: ECDH-session errors (400, 403, decrypt
 // failures) trigger ECDH invalidation + re-exchange, while transient errors
 // (timeouts, 500, rate limits) are retried with the existing ECDH context.
-func (c *Client) retrieveKeyViaECDH(keyURI string) (*Key, error) {
+func (c *Client) retrieveKeyViaECDH(ctx context.Context, keyURI string) (*Key, error) {
 	// Ensure ECDH context exists
-	ecdhCtx, err := c.getOrCreateECDH()
+	ecdhCtx, err := c.getOrCreateECDH(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to establish ECDH context: %w", err)
 	}
 
 	// Build and send the KMS retrieve request
-	key, err := c.doKMSRetrieve(keyURI, ecdhCtx)
+	key, err := c.doKMSRetrieve(ctx, keyURI, ecdhCtx)
 	if err != nil {
 		if isECDHSessionError(err) {
 			// ECDH session is invalid — invalidate and re-exchange
 			c.invalidateECDH()
-			ecdhCtx, retryErr := c.getOrCreateECDH()
+			ecdhCtx, retryErr := c.getOrCreateECDH(ctx)
 			if retryErr != nil {
 				return nil, fmt.Errorf("retry ECDH failed: %w (original: %v)", retryErr, err)
 			}
-			key, retryErr = c.doKMSRetrieve(keyURI, ecdhCtx)
+			key, retryErr = c.doKMSRetrieve(ctx, keyURI, ecdhCtx)
 			if retryErr != nil {
 				return nil, fmt.Errorf("retry KMS retrieve failed: %w (original: %v)", retryErr, err)
 			}
@@ -110,7 +127,7 @@ func (c *Client) retrieveKeyViaECDH(keyURI string) (*Key, error) {
 		}
 
 		// Transient error — retry once with the same ECDH context
-		key, retryErr := c.doKMSRetrieve(keyURI, ecdhCtx)
+		key, retryErr := c.doKMSRetrieve(ctx, keyURI, ecdhCtx)
 		if retryErr != nil {
 			return nil, fmt.Errorf("retry KMS retrieve failed: %w (original: %v)", retryErr, err)
 		}
@@ -123,8 +140,8 @@ func (c *Client) retrieveKeyViaECDH(keyURI string) (*Key, error) {
 // doKMSRetrieve performs a single KMS key retrieval attempt.
 // It sends the request via HTTP, and if the response is async (202),
 // waits for the response to arrive via Mercury WebSocket.
-func (c *Client) doKMSRetrieve(keyURI string, ecdhCtx *ECDHContext) (*Key, error) {
-	userID, _ := c.getUserID()
+func (c *Client) doKMSRetrieve(ctx context.Context, keyURI string, ecdhCtx *ECDHContext) (*Key, error) {
+	userID, _ := c.getUserID(ctx)
 	requestID := generateRequestID()
 
 	// Register pending request BEFORE sending so we don't miss the response
@@ -162,7 +179,7 @@ func (c *Client) doKMSRetrieve(keyURI string, ecdhCtx *ECDHContext) (*Key, error
 	}
 
 	// Send to KMS
-	responseJWEs, err := c.sendKMSMessage(wrappedRequest, destination)
+	responseJWEs, err := c.sendKMSMessage(ctx, wrappedRequest, destination)
 	if err != nil {
 		return nil, fmt.Errorf("KMS request failed: %w", err)
 	}
@@ -178,6 +195,8 @@ func (c *Client) doKMSRetrieve(keyURI string, ecdhCtx *ECDHContext) (*Key, error
 		return c.parseKeyFromPayload(payload)
 	case <-time.After(kmsResponseTimeout):
 		return nil, fmt.Errorf("timeout waiting for KMS key response via Mercury")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -215,7 +234,7 @@ func (c *Client) parseKeyFromPayload(payload []byte) (*Key, error) {
 
 // sendKMSMessage sends a wrapped KMS message to the encryption service.
 // Returns response JWEs for synchronous (200) responses, or nil for async (202) responses.
-func (c *Client) sendKMSMessage(wrappedMessage string, destination string) ([]string, error) {
+func (c *Client) sendKMSMessage(ctx context.Context, wrappedMessage string, destination string) ([]string, error) {
 	envelope := &KMSEnvelope{
 		KMSMessages: []string{wrappedMessage},
 		Destination: destination,
@@ -228,10 +247,12 @@ func (c *Client) sendKMSMessage(wrappedMessage string, destination string) ([]st
 
 	// Always send to the default cluster's encryption endpoint.
 	// The 'destination' field in the envelope handles routing to the correct KMS cluster.
-	kmsEndpoint := fmt.Sprintf("https://encryption-%s.wbx2.com/encryption/api/v1/kms/messages",
-		c.config.DefaultCluster)
+	kmsEndpoint, err := c.kmsURL(c.config.DefaultCluster, "messages")
+	if err != nil {
+		return nil, fmt.Errorf("error creating KMS request: %w", err)
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.config.HTTPTimeout)
+	ctx, cancel := context.WithTimeout(ctx, c.config.HTTPTimeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, kmsEndpoint, bytes.NewBuffer(envelopeJSON))
@@ -242,7 +263,7 @@ func (c *Client) sendKMSMessage(wrappedMessage string, destination string) ([]st
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+c.webexClient.GetAccessToken())
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error sending KMS request: %w", err)
 	}
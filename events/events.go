@@ -7,12 +7,18 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/WebexCommunity/webex-go-sdk/v2/meetings"
+	"github.com/WebexCommunity/webex-go-sdk/v2/memberships"
+	"github.com/WebexCommunity/webex-go-sdk/v2/messages"
+	"github.com/WebexCommunity/webex-go-sdk/v2/rooms"
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
 )
 
@@ -27,6 +33,71 @@ type Event struct {
 	Created  time.Time               `json:"created,omitempty"`
 	Data     EventData               `json:"data,omitempty"`
 	Errors   webexsdk.ResourceErrors `json:"errors,omitempty"`
+
+	// RawData is the undecoded data field, for DecodeData.
+	RawData json.RawMessage `json:"-"`
+}
+
+// Resources of compliance events that DecodeData decodes
+const (
+	ResourceMessages    = "messages"
+	ResourceMemberships = "memberships"
+	ResourceMeetings    = "meetings"
+	ResourceRooms       = "rooms"
+)
+
+// UnmarshalJSON decodes an event, keeping its data field in RawData.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+	var raw struct {
+		event
+		Data json.RawMessage `json:"data,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*e = Event(raw.event)
+	e.RawData = raw.Data
+	if len(raw.Data) > 0 && string(raw.Data) != "null" {
+		if err := json.Unmarshal(raw.Data, &e.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeData decodes the event's data into the resource named by
+// Resource: a *messages.Message, *memberships.Membership,
+// *meetings.Meeting or *rooms.Room. Data holds the fields common to all
+// resources; DecodeData gives the typed view, e.g.
+//
+//	switch data := data.(type) {
+//	case *messages.Message:
+//		archiveMessage(event, data)
+//	case *memberships.Membership:
+//		// ...
+//	}
+func (e *Event) DecodeData() (any, error) {
+	var data any
+	switch e.Resource {
+	case ResourceMessages:
+		data = &messages.Message{}
+	case ResourceMemberships:
+		data = &memberships.Membership{}
+	case ResourceMeetings:
+		data = &meetings.Meeting{}
+	case ResourceRooms:
+		data = &rooms.Room{}
+	default:
+		return nil, fmt.Errorf("unsupported event resource %q", e.Resource)
+	}
+	if len(e.RawData) == 0 {
+		return nil, fmt.Errorf("event %s has no data", e.ID)
+	}
+	if err := json.Unmarshal(e.RawData, data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event data: %w", e.Resource, err)
+	}
+	return data, nil
 }
 
 // EventData represents the data field of an event
@@ -77,10 +148,7 @@ type ListOptions struct {
 }
 
 // EventsPage represents a paginated list of events
-type EventsPage struct {
-	Items []Event `json:"items"`
-	*webexsdk.Page
-}
+type EventsPage = webexsdk.TypedPage[Event]
 
 // Config holds the configuration for the Events plugin
 type Config struct {
@@ -112,6 +180,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // List returns a list of events with optional filters
 func (c *Client) List(options *ListOptions) (*EventsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*EventsPage, error) {
 	params := url.Values{}
 	if options != nil {
 		if options.Resource != "" {
@@ -134,7 +207,7 @@ func (c *Client) List(options *ListOptions) (*EventsPage, error) {
 		}
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "events", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "events", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,31 +217,41 @@ func (c *Client) List(options *ListOptions) (*EventsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Events
-	eventsPage := &EventsPage{
-		Page:  page,
-		Items: make([]Event, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Event](page)
+}
 
-	for i, item := range page.Items {
-		var event Event
-		if err := json.Unmarshal(item, &event); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Event matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Event] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Event, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		eventsPage.Items[i] = event
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return eventsPage, nil
+// All returns a range-over-func sequence of every Event matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Event, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Get returns details for an event by ID
 func (c *Client) Get(eventID string) (*Event, error) {
+	return c.GetCtx(context.Background(), eventID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, eventID string) (*Event, error) {
 	if eventID == "" {
 		return nil, fmt.Errorf("eventID is required")
 	}
 
 	path := fmt.Sprintf("events/%s", eventID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
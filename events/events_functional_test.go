@@ -9,6 +9,7 @@
 package events
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -176,7 +177,7 @@ func TestFunctionalEventsCursorNavigation(t *testing.T) {
 		t.Fatalf("List failed: %v", err)
 	}
 
-	if !page.HasNext {
+	if !page.HasNext() {
 		t.Log("Only one page of results — skipping cursor navigation test")
 		return
 	}
@@ -194,3 +195,40 @@ func TestFunctionalEventsCursorNavigation(t *testing.T) {
 		t.Error("Expected items from cursor navigation")
 	}
 }
+
+// TestFunctionalEventsStream streams the last hour of message events and
+// decodes their data
+// Run with:
+//
+//	WEBEX_ACCESS_TOKEN=<your-token> go test -tags functional -run TestFunctionalEventsStream -v ./events/
+func TestFunctionalEventsStream(t *testing.T) {
+	client := functionalClient(t)
+	eventsClient := New(client, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var count int
+	for event, err := range eventsClient.Stream(ctx, &StreamOptions{
+		Resource:  ResourceMessages,
+		Start:     time.Now().Add(-time.Hour),
+		MaxWindow: 15 * time.Minute,
+	}) {
+		if err != nil {
+			skipOn403(t, err)
+			if errors.Is(err, context.DeadlineExceeded) {
+				break
+			}
+			t.Fatalf("Stream failed: %v", err)
+		}
+		data, err := event.DecodeData()
+		if err != nil {
+			t.Fatalf("DecodeData failed for %s: %v", event.ID, err)
+		}
+		t.Logf("Event %s (%s) created %s: %T", event.ID, event.Type, event.Created.Format(time.RFC3339), data)
+		if count++; count == 5 {
+			break
+		}
+	}
+	t.Logf("Streamed %d events", count)
+}
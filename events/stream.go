@@ -0,0 +1,255 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package events
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// StreamOptions holds the options for Stream.
+type StreamOptions struct {
+	// Resource, Type and ActorID filter the events, as in ListOptions.
+	Resource string
+	Type     string
+	ActorID  string
+
+	// Checkpoint persists the stream's position, e.g. a
+	// webexsdk.FileCheckpointStore. Default: a
+	// webexsdk.MemoryCheckpointStore.
+	Checkpoint webexsdk.CheckpointStore
+
+	// Start is where the stream begins when Checkpoint holds no
+	// checkpoint. Default: the time Stream is called.
+	Start time.Time
+
+	// Interval is the time between polls once the stream has caught up.
+	// Default: 30 seconds.
+	Interval time.Duration
+
+	// Overlap is how far each window reaches back before the end of the
+	// previous one, so that events the API makes visible late are not
+	// missed. Events already yielded are skipped. Default: 5 minutes.
+	Overlap time.Duration
+
+	// MaxWindow caps the span of one from/to window, so that catching up
+	// after downtime proceeds in steps that are each checkpointed.
+	// Default: 24 hours; at least twice Overlap.
+	MaxWindow time.Duration
+
+	// PageSize is the max parameter of each page request. Zero uses the
+	// API's default.
+	PageSize int
+
+	// MaxBackoff caps the wait before retrying a window after a 429 or a
+	// server or network error that outlasted the client's own retries.
+	// The wait starts at one second and doubles; a 429's Retry-After is
+	// honoured. Default: 5 minutes.
+	MaxBackoff time.Duration
+}
+
+// Stream returns a long-running sequence of compliance events, oldest
+// first. It walks from/to windows up to the present and then polls every
+// Interval, so the sequence ends only when ctx is done, the loop breaks or
+// a permanent error occurs; the error is yielded as the final element.
+//
+// An event counts as delivered once it is passed to the loop body. The
+// position is saved to Checkpoint after each window and when the stream
+// ends, so a restarted stream neither loses nor repeats events: windows
+// overlap by Overlap and delivered events are skipped. Only the events
+// delivered after the last save are repeated if the process crashes.
+//
+//	for event, err := range client.Events().Stream(ctx, opts) {
+//		if err != nil {
+//			return err
+//		}
+//		archive(event)
+//	}
+func (c *Client) Stream(ctx context.Context, options *StreamOptions) iter.Seq2[Event, error] {
+	opts := StreamOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Checkpoint == nil {
+		opts.Checkpoint = webexsdk.NewMemoryCheckpointStore()
+	}
+	if opts.Start.IsZero() {
+		opts.Start = time.Now()
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	if opts.Overlap <= 0 {
+		opts.Overlap = 5 * time.Minute
+	}
+	if opts.MaxWindow <= 0 {
+		opts.MaxWindow = 24 * time.Hour
+	}
+	opts.MaxWindow = max(opts.MaxWindow, 2*opts.Overlap)
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 5 * time.Minute
+	}
+
+	return func(yield func(Event, error) bool) {
+		s := &stream{client: c, opts: &opts}
+		if err := s.run(ctx, yield); err != nil {
+			yield(Event{}, err)
+		}
+	}
+}
+
+// stream is the state of one iteration of a Stream.
+type stream struct {
+	client     *Client
+	opts       *StreamOptions
+	checkpoint *webexsdk.Checkpoint
+	first      bool // no window has been completed yet
+	dirty      bool // checkpoint has unsaved changes
+}
+
+// errStopped reports that the loop body broke out of the stream.
+var errStopped = errors.New("stream stopped")
+
+// run yields events until ctx is done, the loop breaks or an error occurs.
+func (s *stream) run(ctx context.Context, yield func(Event, error) bool) (err error) {
+	checkpoint, err := s.opts.Checkpoint.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	s.first = checkpoint == nil
+	if s.first {
+		checkpoint = &webexsdk.Checkpoint{Until: s.opts.Start}
+	}
+	s.checkpoint = checkpoint
+
+	defer func() {
+		if s.dirty {
+			saveErr := s.opts.Checkpoint.Save(context.WithoutCancel(ctx), s.checkpoint)
+			if err == nil && saveErr != nil {
+				err = fmt.Errorf("failed to save checkpoint: %w", saveErr)
+			}
+		}
+		if errors.Is(err, errStopped) {
+			err = nil
+		}
+	}()
+
+	backoff := time.Second
+	for {
+		now := time.Now()
+		for s.checkpoint.Until.Before(now) {
+			events, to, err := s.window(ctx, now)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if !retryable(err) {
+					return err
+				}
+				delay := min(backoff, s.opts.MaxBackoff)
+				var apiErr *webexsdk.APIError
+				if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+					delay = apiErr.RetryAfter
+				}
+				backoff = min(2*backoff, s.opts.MaxBackoff)
+				if err := sleep(ctx, delay); err != nil {
+					return err
+				}
+				continue
+			}
+			backoff = time.Second
+
+			for _, event := range events {
+				s.checkpoint.MarkSeen(event.ID, event.Created)
+				s.dirty = true
+				if !yield(event, nil) {
+					return errStopped
+				}
+			}
+			s.checkpoint.Advance(to, s.opts.Overlap)
+			s.first = false
+			if err := s.opts.Checkpoint.Save(ctx, s.checkpoint); err != nil {
+				return fmt.Errorf("failed to save checkpoint: %w", err)
+			}
+			s.dirty = false
+		}
+
+		if err := sleep(ctx, s.opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// window lists the events of the window after the checkpoint that ends at
+// or before now. It returns the new events, oldest first, and the end of
+// the window.
+func (s *stream) window(ctx context.Context, now time.Time) ([]Event, time.Time, error) {
+	from := s.checkpoint.Until.Add(-s.opts.Overlap)
+	if s.first {
+		from = s.checkpoint.Until
+	}
+	to := s.checkpoint.Until.Add(s.opts.MaxWindow)
+	if to.After(now) {
+		to = now
+	}
+
+	events, err := s.client.ListAll(ctx, &ListOptions{
+		Resource: s.opts.Resource,
+		Type:     s.opts.Type,
+		ActorID:  s.opts.ActorID,
+		From:     formatTime(from),
+		To:       formatTime(to),
+		Max:      s.opts.PageSize,
+	}).Collect()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	seen := make(map[string]bool, len(events))
+	var fresh []Event
+	for _, event := range events {
+		if s.checkpoint.HasSeen(event.ID) || seen[event.ID] {
+			continue
+		}
+		seen[event.ID] = true
+		fresh = append(fresh, event)
+	}
+	slices.SortStableFunc(fresh, func(a, b Event) int {
+		return cmp.Or(a.Created.Compare(b.Created), cmp.Compare(a.ID, b.ID))
+	})
+	return fresh, to, nil
+}
+
+// retryable reports whether a failed window should be retried after a
+// backoff: 429s, server errors and transient network errors.
+func retryable(err error) bool {
+	return webexsdk.IsRateLimited(err) || webexsdk.IsServerError(err) || webexsdk.IsTransientError(err)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// formatTime formats t for ListOptions.From and ListOptions.To.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
@@ -0,0 +1,216 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/memberships"
+	"github.com/WebexCommunity/webex-go-sdk/v2/messages"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// eventServer serves the events whose creation time falls in the requested
+// window, newest first. It answers the first request with a 429.
+type eventServer struct {
+	mu          sync.Mutex
+	events      []map[string]any
+	rateLimited bool
+}
+
+func (s *eventServer) add(id string, created time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append([]map[string]any{{
+		"id":       id,
+		"resource": ResourceMessages,
+		"type":     "created",
+		"created":  created.Format(time.RFC3339Nano),
+		"data":     map[string]any{"id": id, "roomId": "room-1", "text": "hello"},
+	}}, s.events...)
+}
+
+func (s *eventServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.rateLimited {
+		s.rateLimited = true
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	q := r.URL.Query()
+	from, _ := time.Parse(time.RFC3339, q.Get("from"))
+	to, _ := time.Parse(time.RFC3339, q.Get("to"))
+	var matching []map[string]any
+	for _, event := range s.events {
+		created, _ := time.Parse(time.RFC3339Nano, event["created"].(string))
+		if !created.Before(from) && !created.After(to) {
+			matching = append(matching, event)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"items": matching})
+}
+
+func TestStream(t *testing.T) {
+	fake := &eventServer{}
+	server := httptest.NewServer(http.HandlerFunc(fake.handle))
+	defer server.Close()
+
+	client, err := webexsdk.NewClient("test-token", &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+		MaxRetries: 0,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	eventsClient := New(client, nil)
+
+	start := time.Now().Add(-3 * time.Hour)
+	for i := 0; i < 3; i++ {
+		fake.add(fmt.Sprintf("event-%d", i), start.Add(30*time.Minute+time.Duration(i)*time.Hour))
+	}
+
+	store := webexsdk.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	opts := &StreamOptions{
+		Resource:   ResourceMessages,
+		Checkpoint: store,
+		Start:      start,
+		Interval:   time.Millisecond,
+		Overlap:    10 * time.Minute,
+		MaxWindow:  time.Hour,
+		MaxBackoff: time.Millisecond,
+	}
+
+	// The 429 is retried and the stream breaks after two events
+	var delivered []string
+	for event, err := range eventsClient.Stream(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		delivered = append(delivered, event.ID)
+		if len(delivered) == 2 {
+			break
+		}
+	}
+	if fmt.Sprint(delivered) != "[event-0 event-1]" {
+		t.Fatalf("Expected event-0 and event-1, got %v", delivered)
+	}
+
+	// A restarted stream picks up an event that showed up late within the
+	// overlap and does not repeat event-1
+	fake.add("late", start.Add(55*time.Minute))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	delivered = nil
+	var streamErr error
+	for event, err := range eventsClient.Stream(ctx, opts) {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		delivered = append(delivered, event.ID)
+		if len(delivered) == 2 {
+			cancel()
+		}
+	}
+	if fmt.Sprint(delivered) != "[late event-2]" {
+		t.Errorf("Expected late and event-2, got %v", delivered)
+	}
+	if !errors.Is(streamErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", streamErr)
+	}
+
+	checkpoint, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to load checkpoint: %v", err)
+	}
+	if checkpoint.LastEventID != "event-2" || checkpoint.Until.Before(start.Add(3*time.Hour)) {
+		t.Errorf("Unexpected checkpoint: %+v", checkpoint)
+	}
+}
+
+func TestStreamError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"Compliance officer role required"}`))
+	}))
+	defer server.Close()
+
+	client, err := webexsdk.NewClient("test-token", &webexsdk.Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var count int
+	for _, err := range New(client, nil).Stream(context.Background(), &StreamOptions{Start: time.Now().Add(-time.Hour)}) {
+		count++
+		if !webexsdk.IsForbidden(err) {
+			t.Errorf("Expected forbidden error, got %v", err)
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected the error as the only element, got %d elements", count)
+	}
+}
+
+func TestDecodeData(t *testing.T) {
+	var event Event
+	err := json.Unmarshal([]byte(`{
+		"id": "event-1",
+		"resource": "memberships",
+		"type": "created",
+		"data": {"id": "membership-1", "roomId": "room-1", "personEmail": "alice@example.com", "isModerator": true}
+	}`), &event)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal event: %v", err)
+	}
+	if event.Data.RoomID != "room-1" {
+		t.Errorf("Expected Data.RoomID 'room-1', got '%s'", event.Data.RoomID)
+	}
+
+	data, err := event.DecodeData()
+	if err != nil {
+		t.Fatalf("Failed to decode data: %v", err)
+	}
+	membership, ok := data.(*memberships.Membership)
+	if !ok {
+		t.Fatalf("Expected *memberships.Membership, got %T", data)
+	}
+	if membership.PersonEmail != "alice@example.com" || !membership.IsModerator {
+		t.Errorf("Unexpected membership: %+v", membership)
+	}
+
+	event.Resource = ResourceMessages
+	if data, err := event.DecodeData(); err != nil {
+		t.Errorf("Failed to decode message data: %v", err)
+	} else if _, ok := data.(*messages.Message); !ok {
+		t.Errorf("Expected *messages.Message, got %T", data)
+	}
+
+	event.Resource = "telephony_calls"
+	if _, err := event.DecodeData(); err == nil {
+		t.Error("Expected error for unsupported resource")
+	}
+}
@@ -0,0 +1,288 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package licenses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// License represents a license in a Webex organization
+type License struct {
+	ID                   string                  `json:"id,omitempty"`
+	Name                 string                  `json:"name,omitempty"`
+	TotalUnits           int                     `json:"totalUnits,omitempty"`
+	ConsumedUnits        int                     `json:"consumedUnits,omitempty"`
+	ConsumedByUsers      int                     `json:"consumedByUsers,omitempty"`
+	ConsumedByWorkspaces int                     `json:"consumedByWorkspaces,omitempty"`
+	SubscriptionID       string                  `json:"subscriptionId,omitempty"`
+	SiteURL              string                  `json:"siteUrl,omitempty"`
+	SiteType             string                  `json:"siteType,omitempty"`
+	Errors               webexsdk.ResourceErrors `json:"errors,omitempty"`
+}
+
+// ListOptions contains the options for listing licenses
+type ListOptions struct {
+	// OrgID lists the licenses of another organization, for partner admins
+	OrgID string `url:"orgId,omitempty"`
+}
+
+// LicensesPage represents a paginated list of licenses
+type LicensesPage = webexsdk.TypedPage[License]
+
+// Operations of a LicenseChange or SiteChange
+const (
+	OperationAdd    = "add"
+	OperationRemove = "remove"
+)
+
+// LicenseProperties holds the settings required by Webex Calling licenses
+type LicenseProperties struct {
+	LocationID  string `json:"locationId,omitempty"`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	Extension   string `json:"extension,omitempty"`
+}
+
+// LicenseChange adds or removes one license
+type LicenseChange struct {
+	ID         string             `json:"id"`
+	Operation  string             `json:"operation,omitempty"` // OperationAdd (default) or OperationRemove
+	Properties *LicenseProperties `json:"properties,omitempty"`
+}
+
+// SiteChange adds or removes a Webex Meetings site
+type SiteChange struct {
+	SiteURL     string `json:"siteUrl"`
+	AccountType string `json:"accountType,omitempty"` // "attendee" for attendee-only access
+	Operation   string `json:"operation,omitempty"`   // OperationAdd (default) or OperationRemove
+}
+
+// Assignment changes the licenses of one person, identified by PersonID or
+// Email
+type Assignment struct {
+	PersonID string          `json:"personId,omitempty"`
+	Email    string          `json:"email,omitempty"`
+	OrgID    string          `json:"orgId,omitempty"`
+	Licenses []LicenseChange `json:"licenses,omitempty"`
+	SiteURLs []SiteChange    `json:"siteUrls,omitempty"`
+}
+
+// UserLicenses is the result of an assignment: the licenses the person
+// holds afterwards
+type UserLicenses struct {
+	OrgID    string       `json:"orgId,omitempty"`
+	PersonID string       `json:"personId,omitempty"`
+	Email    string       `json:"email,omitempty"`
+	Licenses []string     `json:"licenses,omitempty"`
+	SiteURLs []SiteChange `json:"siteUrls,omitempty"`
+}
+
+// AssignmentResult is the outcome of one Assignment in AssignMany
+type AssignmentResult struct {
+	// Assignment is the requested change
+	Assignment Assignment
+
+	// Licenses is the person's licenses after the change, if it succeeded
+	Licenses *UserLicenses
+
+	// Err is the error for this person, if the change failed
+	Err error
+}
+
+// AssignmentReport reports the outcome of AssignMany per person, in the
+// order of the assignments
+type AssignmentReport struct {
+	Results []AssignmentResult
+}
+
+// Succeeded returns the results of the assignments that were applied
+func (r *AssignmentReport) Succeeded() []AssignmentResult {
+	var results []AssignmentResult
+	for _, result := range r.Results {
+		if result.Err == nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Failed returns the results of the assignments that failed
+func (r *AssignmentReport) Failed() []AssignmentResult {
+	var results []AssignmentResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Err returns the errors of the failed assignments joined, or nil if every
+// assignment was applied
+func (r *AssignmentReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.Assignment.person(), result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// person returns the identifier of the assignment's person for messages
+func (a *Assignment) person() string {
+	if a.PersonID != "" {
+		return a.PersonID
+	}
+	return a.Email
+}
+
+// Config holds the configuration for the Licenses plugin
+type Config struct {
+	// Any configuration settings for the licenses plugin can go here
+}
+
+// DefaultConfig returns the default configuration for the Licenses plugin
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Client is the licenses API client
+type Client struct {
+	webexClient *webexsdk.Client
+	config      *Config
+}
+
+// New creates a new Licenses plugin
+func New(webexClient *webexsdk.Client, config *Config) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &Client{
+		webexClient: webexClient,
+		config:      config,
+	}
+}
+
+// List returns a list of licenses
+func (c *Client) List(options *ListOptions) (*LicensesPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*LicensesPage, error) {
+	params := url.Values{}
+	if options != nil && options.OrgID != "" {
+		params.Set("orgId", options.OrgID)
+	}
+
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "licenses", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := webexsdk.NewPage(resp, c.webexClient, webexsdk.ResourceLicenses)
+	if err != nil {
+		return nil, err
+	}
+
+	return webexsdk.NewTypedPage[License](page)
+}
+
+// ListAll returns an Iterator over every License matching options,
+// following pagination links across pages. Use Limit on the returned
+// Iterator to cap the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[License] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]License, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.Page, nil
+	})
+}
+
+// All returns a range-over-func sequence of every License matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[License, error] {
+	return c.ListAll(ctx, options).All()
+}
+
+// Get returns details for a license
+func (c *Client) Get(licenseID string) (*License, error) {
+	return c.GetCtx(context.Background(), licenseID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, licenseID string) (*License, error) {
+	if licenseID == "" {
+		return nil, fmt.Errorf("licenseID is required")
+	}
+
+	path := fmt.Sprintf("licenses/%s", licenseID)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var license License
+	if err := webexsdk.ParseResponse(resp, &license); err != nil {
+		return nil, err
+	}
+
+	return &license, nil
+}
+
+// Assign adds and removes licenses and meeting sites of one person
+func (c *Client) Assign(assignment *Assignment) (*UserLicenses, error) {
+	return c.AssignCtx(context.Background(), assignment)
+}
+
+// AssignCtx is like Assign but uses ctx for the request.
+func (c *Client) AssignCtx(ctx context.Context, assignment *Assignment) (*UserLicenses, error) {
+	if assignment.PersonID == "" && assignment.Email == "" {
+		return nil, fmt.Errorf("personId or email is required")
+	}
+	if len(assignment.Licenses) == 0 && len(assignment.SiteURLs) == 0 {
+		return nil, fmt.Errorf("at least one license or site change is required")
+	}
+
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPatch, "licenses/users", nil, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserLicenses
+	if err := webexsdk.ParseResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AssignMany applies each assignment in turn. The API changes one person
+// per request, so some assignments may fail while others succeed; the
+// report holds the outcome for every person, and its Err method returns
+// the failures joined. Assignments that hit a 429 or a server error are
+// retried as by webexsdk.RunBulk. Once ctx is done, the remaining
+// assignments fail with ctx's error without being sent.
+func (c *Client) AssignMany(ctx context.Context, assignments []Assignment) *AssignmentReport {
+	bulk := webexsdk.RunBulk(ctx, assignments, func(ctx context.Context, assignment Assignment) (*UserLicenses, error) {
+		return c.AssignCtx(ctx, &assignment)
+	}, &webexsdk.BulkConfig{Concurrency: 1})
+
+	report := &AssignmentReport{Results: make([]AssignmentResult, len(bulk.Results))}
+	for i, result := range bulk.Results {
+		report.Results[i] = AssignmentResult{Assignment: result.Item, Licenses: result.Value, Err: result.Err}
+	}
+	return report
+}
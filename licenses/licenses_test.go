@@ -0,0 +1,198 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package licenses
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	baseURL, _ := url.Parse(server.URL)
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.BaseURL = baseURL
+
+	return New(client, nil), server
+}
+
+func TestList(t *testing.T) {
+	licensesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/licenses" {
+			t.Errorf("Expected path '/licenses', got '%s'", r.URL.Path)
+		}
+		if r.URL.Query().Get("orgId") != "test-org-id" {
+			t.Errorf("Expected orgId 'test-org-id', got '%s'", r.URL.Query().Get("orgId"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []License{
+				{ID: "license-1", Name: "Messaging", TotalUnits: 100, ConsumedUnits: 40, ConsumedByUsers: 40},
+				{ID: "license-2", Name: "Meeting - Webex Enterprise Edition", SiteURL: "example.webex.com", SiteType: "Control Hub managed site"},
+			},
+		})
+	})
+	defer server.Close()
+
+	page, err := licensesPlugin.List(&ListOptions{OrgID: "test-org-id"})
+	if err != nil {
+		t.Fatalf("Failed to list licenses: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Fatalf("Expected 2 licenses, got %d", len(page.Items))
+	}
+	if page.Items[0].TotalUnits != 100 || page.Items[0].ConsumedUnits != 40 {
+		t.Errorf("Unexpected units: %+v", page.Items[0])
+	}
+	if page.Items[1].SiteURL != "example.webex.com" {
+		t.Errorf("Expected siteUrl 'example.webex.com', got '%s'", page.Items[1].SiteURL)
+	}
+}
+
+func TestGet(t *testing.T) {
+	licensesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/licenses/license-1" {
+			t.Errorf("Expected path '/licenses/license-1', got '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(License{ID: "license-1", Name: "Messaging", SubscriptionID: "sub-1"})
+	})
+	defer server.Close()
+
+	license, err := licensesPlugin.Get("license-1")
+	if err != nil {
+		t.Fatalf("Failed to get license: %v", err)
+	}
+	if license.SubscriptionID != "sub-1" {
+		t.Errorf("Expected subscriptionId 'sub-1', got '%s'", license.SubscriptionID)
+	}
+
+	if _, err := licensesPlugin.Get(""); err == nil {
+		t.Error("Expected error for empty licenseID")
+	}
+}
+
+func TestAssign(t *testing.T) {
+	licensesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/licenses/users" {
+			t.Errorf("Expected path '/licenses/users', got '%s'", r.URL.Path)
+		}
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected method PATCH, got %s", r.Method)
+		}
+
+		var assignment Assignment
+		if err := json.NewDecoder(r.Body).Decode(&assignment); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if len(assignment.Licenses) != 2 || assignment.Licenses[1].Operation != OperationRemove {
+			t.Errorf("Unexpected license changes: %+v", assignment.Licenses)
+		}
+		if assignment.Licenses[0].Properties == nil || assignment.Licenses[0].Properties.Extension != "1234" {
+			t.Errorf("Expected calling properties, got %+v", assignment.Licenses[0].Properties)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(UserLicenses{
+			OrgID:    "test-org-id",
+			PersonID: assignment.PersonID,
+			Licenses: []string{"license-1"},
+		})
+	})
+	defer server.Close()
+
+	result, err := licensesPlugin.Assign(&Assignment{
+		PersonID: "person-1",
+		Licenses: []LicenseChange{
+			{ID: "license-1", Operation: OperationAdd, Properties: &LicenseProperties{LocationID: "loc-1", Extension: "1234"}},
+			{ID: "license-2", Operation: OperationRemove},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to assign licenses: %v", err)
+	}
+	if result.PersonID != "person-1" || len(result.Licenses) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+
+	if _, err := licensesPlugin.Assign(&Assignment{Licenses: []LicenseChange{{ID: "license-1"}}}); err == nil {
+		t.Error("Expected error without personId or email")
+	}
+	if _, err := licensesPlugin.Assign(&Assignment{PersonID: "person-1"}); err == nil {
+		t.Error("Expected error without changes")
+	}
+}
+
+func TestAssignMany(t *testing.T) {
+	licensesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var assignment Assignment
+		_ = json.NewDecoder(r.Body).Decode(&assignment)
+
+		w.Header().Set("Content-Type", "application/json")
+		if assignment.Email == "no-seats@example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"No available units for license","trackingId":"t-1"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(UserLicenses{Email: assignment.Email, Licenses: []string{"license-1"}})
+	})
+	defer server.Close()
+
+	add := []LicenseChange{{ID: "license-1"}}
+	report := licensesPlugin.AssignMany(context.Background(), []Assignment{
+		{Email: "alice@example.com", Licenses: add},
+		{Email: "no-seats@example.com", Licenses: add},
+		{Email: "bob@example.com", Licenses: add},
+	})
+
+	if len(report.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(report.Results))
+	}
+	if len(report.Succeeded()) != 2 {
+		t.Errorf("Expected 2 successes, got %d", len(report.Succeeded()))
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].Assignment.Email != "no-seats@example.com" {
+		t.Fatalf("Expected the second assignment to fail, got %+v", failed)
+	}
+	var apiErr *webexsdk.APIError
+	if !errors.As(failed[0].Err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 API error, got %v", failed[0].Err)
+	}
+	if report.Results[2].Licenses == nil || report.Results[2].Licenses.Email != "bob@example.com" {
+		t.Errorf("Expected the assignment after the failure to be applied, got %+v", report.Results[2])
+	}
+	if report.Err() == nil {
+		t.Error("Expected report error")
+	}
+
+	// Assignments after ctx is done are not sent
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report = licensesPlugin.AssignMany(ctx, []Assignment{{Email: "alice@example.com", Licenses: add}})
+	if report.Results[0].Err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", report.Results[0].Err)
+	}
+}
@@ -7,8 +7,9 @@
 package meetings
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -258,10 +259,7 @@ type ParticipantDevice struct {
 }
 
 // ParticipantsPage represents a paginated list of meeting participants
-type ParticipantsPage struct {
-	Items []Participant `json:"items"`
-	*webexsdk.Page
-}
+type ParticipantsPage = webexsdk.TypedPage[Participant]
 
 // ParticipantListOptions contains the options for listing meeting participants
 type ParticipantListOptions struct {
@@ -286,10 +284,7 @@ type ListOptions struct {
 }
 
 // MeetingsPage represents a paginated list of meetings
-type MeetingsPage struct {
-	Items []Meeting `json:"items"`
-	*webexsdk.Page
-}
+type MeetingsPage = webexsdk.TypedPage[Meeting]
 
 // Config holds the configuration for the Meetings plugin
 type Config struct {
@@ -325,6 +320,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 // rather than actual meeting instances. Use meetingType="meeting" with state="ended"
 // and a from/to date range to list past meeting instances.
 func (c *Client) List(options *ListOptions) (*MeetingsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*MeetingsPage, error) {
 	params := url.Values{}
 
 	if options != nil {
@@ -368,7 +368,7 @@ func (c *Client) List(options *ListOptions) (*MeetingsPage, error) {
 		}
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "meetings", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "meetings", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -378,25 +378,35 @@ func (c *Client) List(options *ListOptions) (*MeetingsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Meetings
-	meetingsPage := &MeetingsPage{
-		Page:  page,
-		Items: make([]Meeting, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Meeting](page)
+}
 
-	for i, item := range page.Items {
-		var meeting Meeting
-		if err := json.Unmarshal(item, &meeting); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Meeting matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Meeting] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Meeting, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		meetingsPage.Items[i] = meeting
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return meetingsPage, nil
+// All returns a range-over-func sequence of every Meeting matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Meeting, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Create creates a new meeting
 func (c *Client) Create(meeting *Meeting) (*Meeting, error) {
+	return c.CreateCtx(context.Background(), meeting)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, meeting *Meeting) (*Meeting, error) {
 	if meeting.Title == "" {
 		return nil, fmt.Errorf("meeting title is required")
 	}
@@ -407,7 +417,7 @@ func (c *Client) Create(meeting *Meeting) (*Meeting, error) {
 		return nil, fmt.Errorf("meeting end time is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "meetings", nil, meeting)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "meetings", nil, meeting)
 	if err != nil {
 		return nil, err
 	}
@@ -422,12 +432,17 @@ func (c *Client) Create(meeting *Meeting) (*Meeting, error) {
 
 // Get returns details for a meeting
 func (c *Client) Get(meetingID string) (*Meeting, error) {
+	return c.GetCtx(context.Background(), meetingID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, meetingID string) (*Meeting, error) {
 	if meetingID == "" {
 		return nil, fmt.Errorf("meetingID is required")
 	}
 
 	path := fmt.Sprintf("meetings/%s", meetingID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -442,6 +457,11 @@ func (c *Client) Get(meetingID string) (*Meeting, error) {
 
 // Update updates an existing meeting
 func (c *Client) Update(meetingID string, meeting *Meeting) (*Meeting, error) {
+	return c.UpdateCtx(context.Background(), meetingID, meeting)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, meetingID string, meeting *Meeting) (*Meeting, error) {
 	if meetingID == "" {
 		return nil, fmt.Errorf("meetingID is required")
 	}
@@ -450,7 +470,7 @@ func (c *Client) Update(meetingID string, meeting *Meeting) (*Meeting, error) {
 	}
 
 	path := fmt.Sprintf("meetings/%s", meetingID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, meeting)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, meeting)
 	if err != nil {
 		return nil, err
 	}
@@ -465,12 +485,17 @@ func (c *Client) Update(meetingID string, meeting *Meeting) (*Meeting, error) {
 
 // Delete deletes a meeting
 func (c *Client) Delete(meetingID string) error {
+	return c.DeleteCtx(context.Background(), meetingID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, meetingID string) error {
 	if meetingID == "" {
 		return fmt.Errorf("meetingID is required")
 	}
 
 	path := fmt.Sprintf("meetings/%s", meetingID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -487,12 +512,17 @@ func (c *Client) Delete(meetingID string) error {
 // Patch partially updates a meeting (only the provided fields).
 // Use this when you want to update specific fields without resending the entire meeting object.
 func (c *Client) Patch(meetingID string, patch interface{}) (*Meeting, error) {
+	return c.PatchCtx(context.Background(), meetingID, patch)
+}
+
+// PatchCtx is like Patch but uses ctx for the request.
+func (c *Client) PatchCtx(ctx context.Context, meetingID string, patch interface{}) (*Meeting, error) {
 	if meetingID == "" {
 		return nil, fmt.Errorf("meetingID is required")
 	}
 
 	path := fmt.Sprintf("meetings/%s", meetingID)
-	resp, err := c.webexClient.Request(http.MethodPatch, path, nil, patch)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPatch, path, nil, patch)
 	if err != nil {
 		return nil, err
 	}
@@ -508,6 +538,11 @@ func (c *Client) Patch(meetingID string, patch interface{}) (*Meeting, error) {
 // ListParticipants returns a list of participants for a meeting instance.
 // Requires a meetingId that is a meeting instance ID (ended meetings).
 func (c *Client) ListParticipants(options *ParticipantListOptions) (*ParticipantsPage, error) {
+	return c.ListParticipantsCtx(context.Background(), options)
+}
+
+// ListParticipantsCtx is like ListParticipants but uses ctx for the request.
+func (c *Client) ListParticipantsCtx(ctx context.Context, options *ParticipantListOptions) (*ParticipantsPage, error) {
 	if options == nil || options.MeetingID == "" {
 		return nil, fmt.Errorf("meetingId is required")
 	}
@@ -522,7 +557,7 @@ func (c *Client) ListParticipants(options *ParticipantListOptions) (*Participant
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "meetingParticipants", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "meetingParticipants", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -532,24 +567,35 @@ func (c *Client) ListParticipants(options *ParticipantListOptions) (*Participant
 		return nil, err
 	}
 
-	participantsPage := &ParticipantsPage{
-		Page:  page,
-		Items: make([]Participant, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Participant](page)
+}
 
-	for i, item := range page.Items {
-		var participant Participant
-		if err := json.Unmarshal(item, &participant); err != nil {
-			return nil, err
+// ListParticipantsAll returns an Iterator over every Participant matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListParticipantsAll(ctx context.Context, options *ParticipantListOptions) *webexsdk.Iterator[Participant] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Participant, *webexsdk.Page, error) {
+		page, err := c.ListParticipantsCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		participantsPage.Items[i] = participant
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return participantsPage, nil
+// AllParticipants returns a range-over-func sequence of every Participant matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) AllParticipants(ctx context.Context, options *ParticipantListOptions) iter.Seq2[Participant, error] {
+	return c.ListParticipantsAll(ctx, options).All()
 }
 
 // GetParticipant returns details for a specific meeting participant.
 func (c *Client) GetParticipant(participantID string, meetingID string) (*Participant, error) {
+	return c.GetParticipantCtx(context.Background(), participantID, meetingID)
+}
+
+// GetParticipantCtx is like GetParticipant but uses ctx for the request.
+func (c *Client) GetParticipantCtx(ctx context.Context, participantID string, meetingID string) (*Participant, error) {
 	if participantID == "" {
 		return nil, fmt.Errorf("participantID is required")
 	}
@@ -561,7 +607,7 @@ func (c *Client) GetParticipant(participantID string, meetingID string) (*Partic
 	params.Set("meetingId", meetingID)
 
 	path := fmt.Sprintf("meetingParticipants/%s", participantID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, params, nil)
 	if err != nil {
 		return nil, err
 	}
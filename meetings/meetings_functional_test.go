@@ -9,6 +9,7 @@
 package meetings
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -576,13 +577,13 @@ func TestFunctionalMeetingsListPagination(t *testing.T) {
 	t.Logf("Page %d: %d items", pageCount, len(page.Items))
 
 	// Traverse pages (limit to 5 pages to avoid runaway)
-	for page.HasNext && pageCount < 5 {
-		nextPage, err := page.Page.Next()
+	for page.HasNext() && pageCount < 5 {
+		nextPage, err := page.Next(context.Background())
 		if err != nil {
 			t.Fatalf("Failed to get next page: %v", err)
 		}
 
-		page.Page = nextPage
+		page = nextPage
 		pageCount++
 		totalItems += len(nextPage.Items)
 		t.Logf("Page %d: %d items", pageCount, len(nextPage.Items))
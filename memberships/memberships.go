@@ -7,8 +7,9 @@
 package memberships
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -43,10 +44,10 @@ type ListOptions struct {
 }
 
 // MembershipsPage represents a paginated list of memberships
-type MembershipsPage struct {
-	Items []Membership `json:"items"`
-	*webexsdk.Page
-}
+type MembershipsPage = webexsdk.TypedPage[Membership]
+
+// AddReport reports the outcome of AddMany per email, in input order
+type AddReport = webexsdk.BulkReport[string, *Membership]
 
 // Config holds the configuration for the Memberships plugin
 type Config struct {
@@ -78,6 +79,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Create adds a person to a room
 func (c *Client) Create(membership *Membership) (*Membership, error) {
+	return c.CreateCtx(context.Background(), membership)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, membership *Membership) (*Membership, error) {
 	if membership.RoomID == "" {
 		return nil, fmt.Errorf("roomId is required")
 	}
@@ -86,7 +92,7 @@ func (c *Client) Create(membership *Membership) (*Membership, error) {
 		return nil, fmt.Errorf("either personId or personEmail is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "memberships", nil, membership)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "memberships", nil, membership)
 	if err != nil {
 		return nil, err
 	}
@@ -99,14 +105,50 @@ func (c *Client) Create(membership *Membership) (*Membership, error) {
 	return &result, nil
 }
 
+// AddMany adds people to a room by email, several at a time. Some may
+// fail while others succeed; the report holds the outcome for every email.
+// People who are already members are reported as skipped.
+func (c *Client) AddMany(roomID string, emails []string) *AddReport {
+	return c.AddManyCtx(context.Background(), roomID, emails, nil)
+}
+
+// AddManyCtx is like AddMany but uses ctx for the requests and config for
+// the concurrency and retries; config may be nil. Once ctx is done, the
+// remaining emails are skipped.
+//
+// Unless config sets MaxAttempts, each email is tried once: a POST that
+// failed with a 5xx or a dropped connection may still have added the
+// person, and a second attempt would then report them as skipped with a
+// 409. The client still retries 429 responses, which are never applied.
+func (c *Client) AddManyCtx(ctx context.Context, roomID string, emails []string, config *webexsdk.BulkConfig) *AddReport {
+	bulkConfig := webexsdk.BulkConfig{}
+	if config != nil {
+		bulkConfig = *config
+	}
+	if bulkConfig.MaxAttempts <= 0 {
+		bulkConfig.MaxAttempts = 1
+	}
+	if bulkConfig.Skip == nil {
+		bulkConfig.Skip = webexsdk.IsConflict
+	}
+	return webexsdk.RunBulk(ctx, emails, func(ctx context.Context, email string) (*Membership, error) {
+		return c.CreateCtx(ctx, &Membership{RoomID: roomID, PersonEmail: email})
+	}, &bulkConfig)
+}
+
 // Get returns a single membership by ID
 func (c *Client) Get(membershipID string) (*Membership, error) {
+	return c.GetCtx(context.Background(), membershipID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, membershipID string) (*Membership, error) {
 	if membershipID == "" {
 		return nil, fmt.Errorf("membershipID is required")
 	}
 
 	path := fmt.Sprintf("memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +163,11 @@ func (c *Client) Get(membershipID string) (*Membership, error) {
 
 // List returns a list of memberships
 func (c *Client) List(options *ListOptions) (*MembershipsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*MembershipsPage, error) {
 	if options == nil {
 		options = &ListOptions{}
 	}
@@ -144,7 +191,7 @@ func (c *Client) List(options *ListOptions) (*MembershipsPage, error) {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "memberships", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "memberships", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -154,31 +201,41 @@ func (c *Client) List(options *ListOptions) (*MembershipsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Memberships
-	membershipsPage := &MembershipsPage{
-		Page:  page,
-		Items: make([]Membership, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Membership](page)
+}
 
-	for i, item := range page.Items {
-		var membership Membership
-		if err := json.Unmarshal(item, &membership); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Membership matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Membership] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Membership, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		membershipsPage.Items[i] = membership
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return membershipsPage, nil
+// All returns a range-over-func sequence of every Membership matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Membership, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Update updates an existing membership
 func (c *Client) Update(membershipID string, membership *Membership) (*Membership, error) {
+	return c.UpdateCtx(context.Background(), membershipID, membership)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, membershipID string, membership *Membership) (*Membership, error) {
 	if membershipID == "" {
 		return nil, fmt.Errorf("membershipID is required")
 	}
 
 	path := fmt.Sprintf("memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, membership)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, membership)
 	if err != nil {
 		return nil, err
 	}
@@ -193,12 +250,17 @@ func (c *Client) Update(membershipID string, membership *Membership) (*Membershi
 
 // Delete removes a person from a room
 func (c *Client) Delete(membershipID string) error {
+	return c.DeleteCtx(context.Background(), membershipID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, membershipID string) error {
 	if membershipID == "" {
 		return fmt.Errorf("membershipID is required")
 	}
 
 	path := fmt.Sprintf("memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -153,7 +153,7 @@ func TestFunctionalMembershipsCursorNavigation(t *testing.T) {
 		t.Fatalf("List failed: %v", err)
 	}
 
-	if !page.HasNext {
+	if !page.HasNext() {
 		t.Log("Only one page of results — skipping cursor navigation test")
 		return
 	}
@@ -7,10 +7,12 @@
 package memberships
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -450,3 +452,148 @@ func TestDelete(t *testing.T) {
 		t.Fatalf("Failed to delete membership: %v", err)
 	}
 }
+
+func TestAll(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("roomId") != "test-room-id" {
+			t.Errorf("Expected roomId 'test-room-id', got '%s'", r.URL.Query().Get("roomId"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", `<`+serverURL+`/memberships?roomId=test-room-id&cursor=2>; rel="next"`)
+			_, _ = w.Write([]byte(`{"items": [{"id": "membership-1"}, {"id": "membership-2"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items": [{"id": "membership-3"}]}`))
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	membershipsClient := New(client, nil)
+
+	var ids []string
+	for membership, err := range membershipsClient.All(context.Background(), &ListOptions{RoomID: "test-room-id"}) {
+		if err != nil {
+			t.Fatalf("Failed to iterate memberships: %v", err)
+		}
+		ids = append(ids, membership.ID)
+	}
+
+	if len(ids) != 3 || ids[2] != "membership-3" {
+		t.Errorf("Expected 3 memberships across 2 pages, got %v", ids)
+	}
+
+	// Limit stops before fetching the second page
+	items, err := membershipsClient.ListAll(context.Background(), &ListOptions{RoomID: "test-room-id"}).Limit(1).Collect()
+	if err != nil {
+		t.Fatalf("Failed to collect memberships: %v", err)
+	}
+	if len(items) != 1 {
+		t.Errorf("Expected 1 membership, got %d", len(items))
+	}
+}
+
+func TestAddMany(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var membership Membership
+		_ = json.NewDecoder(r.Body).Decode(&membership)
+		if membership.RoomID != "test-room-id" {
+			t.Errorf("Expected roomId 'test-room-id', got '%s'", membership.RoomID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch membership.PersonEmail {
+		case "member@example.com":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"User is already a participant"}`))
+		case "unknown@example.com":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Person not found"}`))
+		default:
+			_ = json.NewEncoder(w).Encode(Membership{ID: "membership-" + membership.PersonEmail, RoomID: membership.RoomID, PersonEmail: membership.PersonEmail})
+		}
+	}))
+	defer server.Close()
+
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	membershipsClient := New(client, nil)
+
+	report := membershipsClient.AddMany("test-room-id", []string{
+		"alice@example.com", "member@example.com", "unknown@example.com", "bob@example.com",
+	})
+
+	if len(report.Succeeded()) != 2 || report.Results[3].Value.PersonEmail != "bob@example.com" {
+		t.Errorf("Expected alice and bob to be added, got %+v", report.Succeeded())
+	}
+	if skipped := report.Skipped(); len(skipped) != 1 || skipped[0].Item != "member@example.com" {
+		t.Errorf("Expected the existing member to be skipped, got %+v", skipped)
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || !webexsdk.IsNotFound(failed[0].Err) {
+		t.Errorf("Expected the unknown person to fail with not found, got %+v", failed)
+	}
+}
+
+func TestAddManyDoesNotRetryByDefault(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The first request adds the person but fails; a retry would see them as a member
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"Internal error"}`))
+			return
+		}
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"Already a member"}`))
+	}))
+	defer server.Close()
+
+	client, err := webexsdk.NewClient("test-token", &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	plugin := New(client, nil)
+
+	report := plugin.AddMany("test-room-id", []string{"alice@example.com"})
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+	if failed := report.Failed(); len(failed) != 1 || !webexsdk.IsServerError(failed[0].Err) {
+		t.Errorf("Expected the 500 to be reported as a failure, got %+v", report.Results)
+	}
+
+	// Retries are opt-in
+	requests.Store(0)
+	report = plugin.AddManyCtx(context.Background(), "test-room-id", []string{"alice@example.com"},
+		&webexsdk.BulkConfig{MaxAttempts: 2, RetryDelay: time.Millisecond})
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests with MaxAttempts 2, got %d", requests.Load())
+	}
+	if skipped := report.Skipped(); len(skipped) != 1 {
+		t.Errorf("Expected the retried email to be skipped, got %+v", report.Results)
+	}
+}
@@ -7,7 +7,9 @@
 package mercury
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -42,10 +44,14 @@ func DefaultConfig() *Config {
 		BackoffTimeReset:            1 * time.Second,
 		MaxRetries:                  3,
 		InitialConnectionMaxRetries: 5,
-		FallbackWebSocketURL:        "wss://mercury-connection-a.wbx2.com/mercury/device",
+		FallbackWebSocketURL:        webexsdk.Commercial.MercuryURL,
 	}
 }
 
+// errAuthRejected indicates that Mercury rejected the access token, either
+// during the WebSocket handshake or in response to the authorization message.
+var errAuthRejected = errors.New("access token rejected")
+
 // DeviceProvider is an interface for getting the websocket URL from a device
 type DeviceProvider interface {
 	Register() error
@@ -102,6 +108,9 @@ type Client struct {
 func New(webexClient *webexsdk.Client, config *Config) *Client {
 	if config == nil {
 		config = DefaultConfig()
+		if webexClient != nil {
+			config.FallbackWebSocketURL = webexClient.Environment().MercuryURL
+		}
 	}
 
 	return &Client{
@@ -130,6 +139,13 @@ func (c *Client) SetCustomWebSocketURL(url string) {
 
 // Connect establishes a websocket connection to the Mercury service
 func (c *Client) Connect() error {
+	return c.ConnectCtx(context.Background())
+}
+
+// ConnectCtx is like Connect but uses ctx for the connection attempts and the
+// backoff between them. Cancelling ctx after ConnectCtx returns does not close
+// the established connection; use Disconnect for that.
+func (c *Client) ConnectCtx(ctx context.Context) error {
 	c.mu.Lock()
 	if c.connected {
 		c.mu.Unlock()
@@ -148,7 +164,7 @@ func (c *Client) Connect() error {
 
 	// If we have a custom URL, use it directly
 	if customURL != "" {
-		return c.connectWithBackoff(customURL)
+		return c.connectWithBackoff(ctx, customURL)
 	}
 
 	// Try to get the websocket URL from the device provider
@@ -178,7 +194,7 @@ func (c *Client) Connect() error {
 		return fmt.Errorf("device provider returned empty WebSocket URL")
 	}
 
-	return c.connectWithBackoff(wsURL)
+	return c.connectWithBackoff(ctx, wsURL)
 }
 
 // Disconnect closes the websocket connection
@@ -297,8 +313,41 @@ func (c *Client) EventHandlers() map[string][]EventHandler {
 	return result
 }
 
-// connectWithBackoff attempts to connect to the Mercury service with exponential backoff
-func (c *Client) connectWithBackoff(wsURL string) error {
+// connectWithBackoff attempts to connect to the Mercury service with exponential
+// backoff, recording a connect or reconnect span and its duration.
+func (c *Client) connectWithBackoff(ctx context.Context, wsURL string) error {
+	spanName := webexsdk.SpanMercuryConnect
+	reconnecting := c.hasConnected
+	if reconnecting {
+		spanName = webexsdk.SpanMercuryReconnect
+		c.webexClient.Meter().AddCount(ctx, webexsdk.MetricMercuryReconnects, 1)
+	}
+
+	start := time.Now()
+	ctx, span := c.webexClient.Tracer().Start(ctx, spanName)
+	err := c.connectLoop(ctx, wsURL)
+
+	attrs := []webexsdk.Attribute{
+		webexsdk.Attr("webex.mercury.reconnect", reconnecting),
+		webexsdk.Attr(webexsdk.AttrRetryAttempts, c.retryCount),
+		webexsdk.Attr(webexsdk.AttrOutcome, outcome(err)),
+	}
+	span.SetAttributes(attrs...)
+	if err != nil {
+		span.RecordError(err)
+		c.webexClient.Slog().Error("mercury connection failed",
+			"reconnect", reconnecting, "attempts", c.retryCount, "error", err)
+	} else if c.IsConnected() {
+		c.webexClient.Slog().Info("mercury connected", "reconnect", reconnecting, "attempts", c.retryCount)
+	}
+	span.End()
+	c.webexClient.Meter().RecordDuration(ctx, webexsdk.MetricMercuryConnectDuration, time.Since(start), attrs...)
+
+	return err
+}
+
+// connectLoop implements connectWithBackoff.
+func (c *Client) connectLoop(ctx context.Context, wsURL string) error {
 	// Reset retry count on new connection attempt
 	c.retryCount = 0
 	c.currentBackoff = c.config.BackoffTimeReset
@@ -310,11 +359,20 @@ func (c *Client) connectWithBackoff(wsURL string) error {
 
 	var err error
 	for c.retryCount <= maxRetries {
-		err = c.attemptConnection(wsURL)
+		err = c.attemptConnection(ctx, wsURL)
 		if err == nil {
 			return nil // Connection successful
 		}
 
+		c.webexClient.Slog().Debug("mercury connection attempt failed",
+			"attempt", c.retryCount+1, "backoff", c.currentBackoff, "error", err)
+
+		// A rejected token will not start working on its own; refresh it so
+		// the next attempt re-authenticates with a new one.
+		if errors.Is(err, errAuthRejected) {
+			_, _ = c.webexClient.RefreshAccessToken(ctx)
+		}
+
 		// Increment retry count
 		c.retryCount++
 		if c.retryCount > maxRetries {
@@ -331,6 +389,11 @@ func (c *Client) connectWithBackoff(wsURL string) error {
 			}
 		case <-c.closeCh:
 			return nil // Stopped by user
+		case <-ctx.Done():
+			c.mu.Lock()
+			c.connecting = false
+			c.mu.Unlock()
+			return fmt.Errorf("failed to connect after %d attempts: %w", c.retryCount, ctx.Err())
 		}
 	}
 
@@ -342,7 +405,7 @@ func (c *Client) connectWithBackoff(wsURL string) error {
 }
 
 // attemptConnection makes a single connection attempt to the Mercury service
-func (c *Client) attemptConnection(wsURL string) error {
+func (c *Client) attemptConnection(ctx context.Context, wsURL string) error {
 	// Get auth token and prepare URL
 	token := c.webexClient.GetAccessToken()
 	parsedURL, err := c.prepareWebSocketURL(wsURL)
@@ -351,7 +414,7 @@ func (c *Client) attemptConnection(wsURL string) error {
 	}
 
 	// Connect to websocket
-	conn, err := c.dialWebSocket(parsedURL.String(), token)
+	conn, err := c.dialWebSocket(ctx, parsedURL.String(), token)
 	if err != nil {
 		return err
 	}
@@ -373,11 +436,13 @@ func (c *Client) attemptConnection(wsURL string) error {
 	c.connected = true
 	c.connecting = false
 	c.hasConnected = true
+	closeCh, done := c.closeCh, c.done
 	c.mu.Unlock()
 
-	// Start ping/pong cycle and message listener
-	go c.startPingPong()
-	go c.listen()
+	// Start ping/pong cycle and message listener. They are given this
+	// connection's channels, which Disconnect and reconnect replace.
+	go c.startPingPong(closeCh, done)
+	go c.listen(closeCh, done)
 
 	return nil
 }
@@ -400,7 +465,7 @@ func (c *Client) prepareWebSocketURL(wsURL string) (*url.URL, error) {
 }
 
 // dialWebSocket establishes a WebSocket connection with proper headers
-func (c *Client) dialWebSocket(url string, token string) (*websocket.Conn, error) {
+func (c *Client) dialWebSocket(ctx context.Context, url string, token string) (*websocket.Conn, error) {
 	headers := make(map[string][]string)
 	headers["Authorization"] = []string{"Bearer " + token}
 	headers["TrackingID"] = []string{fmt.Sprintf("go-sdk_%d", time.Now().UnixMilli())}
@@ -417,8 +482,17 @@ func (c *Client) dialWebSocket(url string, token string) (*websocket.Conn, error
 		}
 	}
 
-	conn, _, err := dialer.Dial(url, headers)
+	if c.webexClient != nil {
+		if err := c.webexClient.CheckURL(url); err != nil {
+			return nil, fmt.Errorf("failed to connect to WebSocket: %w", err)
+		}
+	}
+
+	conn, resp, err := dialer.DialContext(ctx, url, headers)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("failed to connect to WebSocket: %w", errAuthRejected)
+		}
 		return nil, fmt.Errorf("failed to connect to WebSocket: %v", err)
 	}
 
@@ -490,7 +564,7 @@ func (c *Client) waitForAuthConfirmation(conn *websocket.Conn, authChan chan<- e
 
 		// Check for error messages
 		if eventType, ok := event["type"].(string); ok && eventType == "error" {
-			authChan <- fmt.Errorf("authorization failed: %v", event)
+			authChan <- fmt.Errorf("authorization failed: %w: %v", errAuthRejected, event)
 			return
 		}
 	}
@@ -510,13 +584,13 @@ func (c *Client) sendInitialPing(conn *websocket.Conn) error {
 	return conn.WriteMessage(websocket.TextMessage, pingJSON)
 }
 
-// listen reads messages from the websocket
-func (c *Client) listen() {
+// listen reads messages from the websocket, closing done when it returns
+func (c *Client) listen(closeCh, done chan struct{}) {
 	defer func() {
 		c.mu.Lock()
 		c.connected = false
 		c.mu.Unlock()
-		close(c.done)
+		close(done)
 	}()
 
 	for {
@@ -530,7 +604,7 @@ func (c *Client) listen() {
 		_, message, err := conn.ReadMessage()
 		if err != nil {
 			// Connection closed or error occurred
-			c.handleConnectionError(err)
+			c.handleConnectionError(err, closeCh)
 			return
 		}
 
@@ -546,7 +620,7 @@ func (c *Client) listen() {
 }
 
 // handleConnectionError logs the connection error and triggers reconnection if needed
-func (c *Client) handleConnectionError(err error) {
+func (c *Client) handleConnectionError(err error, closeCh chan struct{}) {
 	c.mu.Lock()
 	wasConnected := c.connected
 	c.connected = false
@@ -555,10 +629,11 @@ func (c *Client) handleConnectionError(err error) {
 	// If we were connected and not deliberately disconnected, attempt to reconnect
 	if wasConnected {
 		select {
-		case <-c.closeCh:
+		case <-closeCh:
 			// Client was deliberately disconnected, don't reconnect
 		default:
 			// Connection error, try to reconnect
+			c.webexClient.Slog().Warn("mercury connection lost, reconnecting", "error", err)
 			go c.reconnect()
 		}
 	}
@@ -705,7 +780,8 @@ func (c *Client) dispatchEvent(event *Event) {
 }
 
 // startPingPong begins the ping/pong cycle to keep the connection alive
-func (c *Client) startPingPong() {
+// until closeCh or done is closed
+func (c *Client) startPingPong(closeCh, done chan struct{}) {
 	ticker := time.NewTicker(c.config.PingInterval)
 	defer ticker.Stop()
 
@@ -717,10 +793,10 @@ func (c *Client) startPingPong() {
 				c.reconnect()
 				return
 			}
-		case <-c.closeCh:
+		case <-closeCh:
 			// Connection closed by user
 			return
-		case <-c.done:
+		case <-done:
 			// Connection closed unexpectedly
 			return
 		}
@@ -763,6 +839,8 @@ func (c *Client) handlePong(data string) error {
 		pingTimeMs, err := strconv.ParseInt(data, 10, 64)
 		if err == nil {
 			c.timeOffset = time.Now().UnixMilli() - pingTimeMs
+			c.webexClient.Meter().RecordDuration(context.Background(), webexsdk.MetricMercuryPingLatency,
+				time.Duration(c.timeOffset)*time.Millisecond)
 		}
 	}
 
@@ -818,7 +896,7 @@ func (c *Client) reconnect() {
 		}
 
 		// Try to connect with backoff
-		_ = c.connectWithBackoff(wsURL)
+		_ = c.connectWithBackoff(context.Background(), wsURL)
 	}()
 }
 
@@ -845,5 +923,16 @@ func (c *Client) getReconnectURL(deviceProvider DeviceProvider, customURL string
 	if c.config.FallbackWebSocketURL != "" {
 		return c.config.FallbackWebSocketURL
 	}
-	return "wss://mercury-connection-a.wbx2.com/mercury/device"
+	if c.webexClient != nil {
+		return c.webexClient.Environment().MercuryURL
+	}
+	return webexsdk.Commercial.MercuryURL
+}
+
+// outcome returns the AttrOutcome value for err.
+func outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
 }
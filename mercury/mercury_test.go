@@ -7,12 +7,19 @@
 package mercury
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/gorilla/websocket"
 )
 
 func TestNew(t *testing.T) {
@@ -536,3 +543,199 @@ func (m *mockDeviceProvider) Register() error {
 func (m *mockDeviceProvider) GetWebSocketURL() (string, error) {
 	return m.wsURL, m.err
 }
+
+func TestConnectRefreshesRejectedToken(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		// Read the authorization message, then confirm with buffer state
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		_ = conn.WriteJSON(map[string]interface{}{
+			"id":   "1",
+			"data": map[string]interface{}{"eventType": "mercury.buffer_state"},
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	refreshes := 0
+	ts := webexsdk.NewRefreshingTokenSource(
+		&webexsdk.Token{AccessToken: "stale-token"},
+		func(ctx context.Context, current *webexsdk.Token) (*webexsdk.Token, error) {
+			refreshes++
+			return &webexsdk.Token{AccessToken: "fresh-token"}, nil
+		},
+		nil,
+	)
+	webexClient, err := webexsdk.NewClientWithTokenSource(ts, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	config := DefaultConfig()
+	config.BackoffTimeReset = 10 * time.Millisecond
+	client := New(webexClient, config)
+	client.SetCustomWebSocketURL("ws" + strings.TrimPrefix(server.URL, "http"))
+
+	if err := client.Connect(); err != nil {
+		t.Fatalf("Expected connection after token refresh, got %v", err)
+	}
+	defer func() { _ = client.Disconnect() }()
+
+	if refreshes != 1 {
+		t.Errorf("Expected 1 token refresh, got %d", refreshes)
+	}
+	if !client.IsConnected() {
+		t.Error("Expected client to be connected")
+	}
+}
+
+func TestConnectCtxStopsRetryingWhenCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	webexClient, _ := webexsdk.NewClient("test-token", nil)
+	config := DefaultConfig()
+	config.BackoffTimeReset = time.Hour
+	client := New(webexClient, config)
+	client.SetCustomWebSocketURL("ws" + strings.TrimPrefix(server.URL, "http"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.ConnectCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if client.IsConnected() {
+		t.Error("Expected client not to be connected")
+	}
+
+	// A later Connect is not blocked by the abandoned attempt
+	if err := client.ConnectCtx(ctx); err == nil || strings.Contains(err.Error(), "already in progress") {
+		t.Errorf("Expected a fresh connection attempt, got %v", err)
+	}
+}
+
+// recordingTelemetry is an in-memory webexsdk.Tracer and webexsdk.Meter.
+type recordingTelemetry struct {
+	mu        sync.Mutex
+	spans     map[string]map[string]any
+	durations map[string]int
+	counts    map[string]int64
+}
+
+func newRecordingTelemetry() *recordingTelemetry {
+	return &recordingTelemetry{
+		spans:     make(map[string]map[string]any),
+		durations: make(map[string]int),
+		counts:    make(map[string]int64),
+	}
+}
+
+type recordingSpan struct {
+	t     *recordingTelemetry
+	attrs map[string]any
+}
+
+func (s *recordingSpan) SetAttributes(attrs ...webexsdk.Attribute) {
+	s.t.mu.Lock()
+	defer s.t.mu.Unlock()
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+func (s *recordingSpan) RecordError(err error) {}
+func (s *recordingSpan) End()                  {}
+
+func (r *recordingTelemetry) Start(ctx context.Context, name string, attrs ...webexsdk.Attribute) (context.Context, webexsdk.Span) {
+	r.mu.Lock()
+	span := &recordingSpan{t: r, attrs: make(map[string]any)}
+	r.spans[name] = span.attrs
+	r.mu.Unlock()
+	span.SetAttributes(attrs...)
+	return ctx, span
+}
+
+func (r *recordingTelemetry) RecordDuration(ctx context.Context, name string, d time.Duration, attrs ...webexsdk.Attribute) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.durations[name]++
+}
+
+func (r *recordingTelemetry) AddCount(ctx context.Context, name string, delta int64, attrs ...webexsdk.Attribute) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts[name] += delta
+}
+
+func TestConnectRecordsTelemetry(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		_ = conn.WriteJSON(map[string]interface{}{
+			"id":   "1",
+			"data": map[string]interface{}{"eventType": "mercury.buffer_state"},
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	tel := newRecordingTelemetry()
+	webexClient, _ := webexsdk.NewClient("test-token", &webexsdk.Config{Tracer: tel, Meter: tel})
+
+	client := New(webexClient, nil)
+	client.SetCustomWebSocketURL("ws" + strings.TrimPrefix(server.URL, "http"))
+	if err := client.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer func() { _ = client.Disconnect() }()
+
+	// Pong echoing a ping timestamp records ping latency
+	if err := client.handlePong(fmt.Sprintf("%d", time.Now().Add(-50*time.Millisecond).UnixMilli())); err != nil {
+		t.Fatalf("handlePong failed: %v", err)
+	}
+
+	tel.mu.Lock()
+	defer tel.mu.Unlock()
+	attrs, ok := tel.spans[webexsdk.SpanMercuryConnect]
+	if !ok {
+		t.Fatalf("Expected %s span, got %v", webexsdk.SpanMercuryConnect, tel.spans)
+	}
+	if attrs[webexsdk.AttrOutcome] != "success" || attrs["webex.mercury.reconnect"] != false {
+		t.Errorf("Unexpected connect span attributes: %v", attrs)
+	}
+	if tel.durations[webexsdk.MetricMercuryConnectDuration] != 1 {
+		t.Errorf("Expected connect duration metric, got %v", tel.durations)
+	}
+	if tel.durations[webexsdk.MetricMercuryPingLatency] != 1 {
+		t.Errorf("Expected ping latency metric, got %v", tel.durations)
+	}
+}
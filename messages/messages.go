@@ -7,12 +7,16 @@
 package messages
 
 import (
+	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"log"
+	"io"
+	"iter"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +24,7 @@ import (
 	"github.com/WebexCommunity/webex-go-sdk/v2/device"
 	"github.com/WebexCommunity/webex-go-sdk/v2/mercury"
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 )
 
 // Message represents a Webex message
@@ -67,10 +72,7 @@ type ListOptions struct {
 }
 
 // MessagesPage represents a paginated list of messages
-type MessagesPage struct {
-	Items []Message `json:"items"`
-	*webexsdk.Page
-}
+type MessagesPage = webexsdk.TypedPage[Message]
 
 // MessageHandler is a function that handles a message event
 type MessageHandler func(message *Message)
@@ -117,11 +119,16 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Create posts a new message and/or media content into a room
 func (c *Client) Create(message *Message) (*Message, error) {
+	return c.CreateCtx(context.Background(), message)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, message *Message) (*Message, error) {
 	if message.RoomID == "" && message.ToPersonID == "" && message.ToPersonEmail == "" {
 		return nil, fmt.Errorf("message must contain either roomId, toPersonId, or toPersonEmail")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "messages", nil, message)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "messages", nil, message)
 	if err != nil {
 		return nil, err
 	}
@@ -136,12 +143,17 @@ func (c *Client) Create(message *Message) (*Message, error) {
 
 // Get returns a single message by ID
 func (c *Client) Get(messageID string) (*Message, error) {
+	return c.GetCtx(context.Background(), messageID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, messageID string) (*Message, error) {
 	if messageID == "" {
 		return nil, fmt.Errorf("messageID is required")
 	}
 
 	path := fmt.Sprintf("messages/%s", messageID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +168,11 @@ func (c *Client) Get(messageID string) (*Message, error) {
 
 // List returns a list of messages in a room
 func (c *Client) List(options *ListOptions) (*MessagesPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*MessagesPage, error) {
 	if options == nil || options.RoomID == "" {
 		return nil, fmt.Errorf("roomId is required")
 	}
@@ -204,7 +221,7 @@ func (c *Client) List(options *ListOptions) (*MessagesPage, error) {
 		params.Set("hasFiles", "true")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "messages", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "messages", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -214,31 +231,41 @@ func (c *Client) List(options *ListOptions) (*MessagesPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Messages
-	messagesPage := &MessagesPage{
-		Page:  page,
-		Items: make([]Message, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Message](page)
+}
 
-	for i, item := range page.Items {
-		var message Message
-		if err := json.Unmarshal(item, &message); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Message matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Message] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Message, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		messagesPage.Items[i] = message
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return messagesPage, nil
+// All returns a range-over-func sequence of every Message matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Message, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Update updates an existing message
 func (c *Client) Update(messageID string, message *Message) (*Message, error) {
+	return c.UpdateCtx(context.Background(), messageID, message)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, messageID string, message *Message) (*Message, error) {
 	if messageID == "" {
 		return nil, fmt.Errorf("messageID is required")
 	}
 
 	path := fmt.Sprintf("messages/%s", messageID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, message)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, message)
 	if err != nil {
 		return nil, err
 	}
@@ -253,12 +280,17 @@ func (c *Client) Update(messageID string, message *Message) (*Message, error) {
 
 // Delete deletes a message
 func (c *Client) Delete(messageID string) error {
+	return c.DeleteCtx(context.Background(), messageID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, messageID string) error {
 	if messageID == "" {
 		return fmt.Errorf("messageID is required")
 	}
 
 	path := fmt.Sprintf("messages/%s", messageID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -273,12 +305,28 @@ func (c *Client) Delete(messageID string) error {
 }
 
 // FileUpload represents a file to attach to a message.
-// Provide either FilePath (local file) OR Base64Data + FileName.
+// Provide one of FilePath, Open, Reader, FileBytes or Base64Data. The
+// content is streamed to Webex without being read into memory.
 type FileUpload struct {
 	// FileName is the name of the file (e.g., "report.pdf").
-	// Required when using Base64Data.
+	// Defaults to the base name of FilePath, or "attachment".
 	FileName string
 
+	// FilePath is the path of a local file to upload. The file is
+	// reopened if the upload is retried.
+	FilePath string
+
+	// Open returns a new reader for the file content on every attempt,
+	// so that the upload can be retried.
+	Open func() (io.ReadCloser, error)
+
+	// Reader streams the file content. Uploads from a Reader are not
+	// retried; use Open or FilePath for that.
+	Reader io.Reader
+
+	// Size is the length of the Open or Reader content in bytes, if known.
+	Size int64
+
 	// Base64Data is the base64-encoded file content.
 	// When set, the data is decoded and uploaded as a binary file.
 	Base64Data string
@@ -286,6 +334,10 @@ type FileUpload struct {
 	// FileBytes is the raw file content.
 	// Use this when you already have the file in memory as bytes.
 	FileBytes []byte
+
+	// Progress, if set, is called as the file is uploaded with the number
+	// of bytes sent so far and the total size (-1 if unknown).
+	Progress func(sent, total int64)
 }
 
 // AdaptiveCard represents an Adaptive Card attachment.
@@ -308,6 +360,11 @@ func NewAdaptiveCard(cardBody interface{}) AdaptiveCard {
 // CreateWithAttachment sends a message with file attachments using multipart/form-data.
 // This supports uploading local files directly to Webex (up to 100MB per file).
 func (c *Client) CreateWithAttachment(message *Message, file *FileUpload) (*Message, error) {
+	return c.CreateWithAttachmentCtx(context.Background(), message, file)
+}
+
+// CreateWithAttachmentCtx is like CreateWithAttachment but uses ctx for the request.
+func (c *Client) CreateWithAttachmentCtx(ctx context.Context, message *Message, file *FileUpload) (*Message, error) {
 	if message.RoomID == "" && message.ToPersonID == "" && message.ToPersonEmail == "" {
 		return nil, fmt.Errorf("message must contain either roomId, toPersonId, or toPersonEmail")
 	}
@@ -315,17 +372,12 @@ func (c *Client) CreateWithAttachment(message *Message, file *FileUpload) (*Mess
 		return nil, fmt.Errorf("file is required")
 	}
 
-	// Resolve file bytes
-	fileBytes, err := resolveFileBytes(file)
+	// Resolve the file content
+	upload, err := resolveFile(file)
 	if err != nil {
 		return nil, fmt.Errorf("error resolving file data: %w", err)
 	}
 
-	fileName := file.FileName
-	if fileName == "" {
-		fileName = "attachment"
-	}
-
 	// Build multipart fields from the message
 	var fields []webexsdk.MultipartField
 	if message.RoomID != "" {
@@ -347,15 +399,7 @@ func (c *Client) CreateWithAttachment(message *Message, file *FileUpload) (*Mess
 		fields = append(fields, webexsdk.MultipartField{Name: "parentId", Value: message.ParentID})
 	}
 
-	files := []webexsdk.MultipartFile{
-		{
-			FieldName: "files",
-			FileName:  fileName,
-			Content:   fileBytes,
-		},
-	}
-
-	resp, err := c.webexClient.RequestMultipart("messages", fields, files)
+	resp, err := c.webexClient.RequestMultipartWithRetry(ctx, "messages", fields, []webexsdk.MultipartFile{upload})
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +415,12 @@ func (c *Client) CreateWithAttachment(message *Message, file *FileUpload) (*Mess
 // CreateWithBase64File sends a message with a base64-encoded file attachment.
 // This is a convenience wrapper around CreateWithAttachment for base64 data.
 func (c *Client) CreateWithBase64File(message *Message, fileName string, base64Data string) (*Message, error) {
-	return c.CreateWithAttachment(message, &FileUpload{
+	return c.CreateWithBase64FileCtx(context.Background(), message, fileName, base64Data)
+}
+
+// CreateWithBase64FileCtx is like CreateWithBase64File but uses ctx for the request.
+func (c *Client) CreateWithBase64FileCtx(ctx context.Context, message *Message, fileName string, base64Data string) (*Message, error) {
+	return c.CreateWithAttachmentCtx(ctx, message, &FileUpload{
 		FileName:   fileName,
 		Base64Data: base64Data,
 	})
@@ -381,6 +430,11 @@ func (c *Client) CreateWithBase64File(message *Message, fileName string, base64D
 // The fallbackText is displayed on clients that don't support adaptive cards.
 // The card parameter should be created via NewAdaptiveCard().
 func (c *Client) CreateWithAdaptiveCard(message *Message, card AdaptiveCard, fallbackText string) (*Message, error) {
+	return c.CreateWithAdaptiveCardCtx(context.Background(), message, card, fallbackText)
+}
+
+// CreateWithAdaptiveCardCtx is like CreateWithAdaptiveCard but uses ctx for the request.
+func (c *Client) CreateWithAdaptiveCardCtx(ctx context.Context, message *Message, card AdaptiveCard, fallbackText string) (*Message, error) {
 	if message.RoomID == "" && message.ToPersonID == "" && message.ToPersonEmail == "" {
 		return nil, fmt.Errorf("message must contain either roomId, toPersonId, or toPersonEmail")
 	}
@@ -403,34 +457,71 @@ func (c *Client) CreateWithAdaptiveCard(message *Message, card AdaptiveCard, fal
 		},
 	}
 
-	return c.Create(message)
+	return c.CreateCtx(ctx, message)
 }
 
-// resolveFileBytes converts a FileUpload into raw bytes.
-func resolveFileBytes(file *FileUpload) ([]byte, error) {
-	// Already have raw bytes
-	if len(file.FileBytes) > 0 {
-		return file.FileBytes, nil
-	}
-
-	// Decode base64 data
-	if file.Base64Data != "" {
-		data, err := base64.StdEncoding.DecodeString(file.Base64Data)
+// resolveFile converts a FileUpload into the "files" part of a multipart
+// request.
+func resolveFile(file *FileUpload) (webexsdk.MultipartFile, error) {
+	upload := webexsdk.MultipartFile{
+		FieldName: "files",
+		FileName:  file.FileName,
+		Size:      file.Size,
+		Progress:  file.Progress,
+	}
+
+	switch {
+	case file.Open != nil:
+		upload.Open = file.Open
+	case file.Reader != nil:
+		upload.Reader = file.Reader
+	case file.FilePath != "":
+		info, err := os.Stat(file.FilePath)
+		if err != nil {
+			return upload, err
+		}
+		if upload.FileName == "" {
+			upload.FileName = filepath.Base(file.FilePath)
+		}
+		path := file.FilePath
+		upload.Open = func() (io.ReadCloser, error) { return os.Open(path) }
+		upload.Size = info.Size()
+	case len(file.FileBytes) > 0:
+		// Already have raw bytes
+		upload.Content = file.FileBytes
+	case file.Base64Data != "":
+		// Decode base64 data as it is sent
+		enc, size, err := base64Encoding(file.Base64Data)
 		if err != nil {
-			// Try URL-safe base64
-			data, err = base64.URLEncoding.DecodeString(file.Base64Data)
-			if err != nil {
-				// Try without padding
-				data, err = base64.RawStdEncoding.DecodeString(file.Base64Data)
-				if err != nil {
-					return nil, fmt.Errorf("invalid base64 data: %w", err)
-				}
-			}
+			return upload, err
 		}
-		return data, nil
+		data := file.Base64Data
+		upload.Open = func() (io.ReadCloser, error) {
+			return io.NopCloser(base64.NewDecoder(enc, strings.NewReader(data))), nil
+		}
+		upload.Size = size
+	default:
+		return upload, fmt.Errorf("no file data provided: set FilePath, Open, Reader, FileBytes or Base64Data")
 	}
 
-	return nil, fmt.Errorf("no file data provided: set FileBytes or Base64Data")
+	if upload.FileName == "" {
+		upload.FileName = "attachment"
+	}
+	return upload, nil
+}
+
+// base64Encoding returns the first of standard, URL-safe and unpadded
+// standard base64 that decodes data, and the decoded size, without holding
+// the decoded data in memory.
+func base64Encoding(data string) (*base64.Encoding, int64, error) {
+	var err error
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding} {
+		var n int64
+		if n, err = io.Copy(io.Discard, base64.NewDecoder(enc, strings.NewReader(data))); err == nil {
+			return enc, n, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("invalid base64 data: %w", err)
 }
 
 // Listen starts a real-time stream of message events
@@ -474,7 +565,8 @@ func (c *Client) Listen(handler MessageHandler) error {
 		// Extract message data and convert to a Message
 		message, err := c.activityToMessage(activity)
 		if err != nil {
-			log.Printf("Error converting post activity to message: %v", err)
+			c.webexClient.Slog().Error("error converting post activity to message",
+				"activityId", activity.ID, "conversationId", activity.ConversationID(), "error", err)
 			return
 		}
 
@@ -486,7 +578,8 @@ func (c *Client) Listen(handler MessageHandler) error {
 		// Extract message data and convert to a Message
 		message, err := c.activityToMessage(activity)
 		if err != nil {
-			log.Printf("Error converting share activity to message: %v", err)
+			c.webexClient.Slog().Error("error converting share activity to message",
+				"activityId", activity.ID, "conversationId", activity.ConversationID(), "error", err)
 			return
 		}
 
@@ -507,9 +600,16 @@ func (c *Client) Listen(handler MessageHandler) error {
 		}
 
 		// Fetch the actual message using the Get method
-		message, err := c.Get(objectID)
+		messageID, err := ids.FromUUID(c.conversationClient.Cluster(), ids.KindMessage, objectID)
 		if err != nil {
-			log.Printf("Error fetching message %s: %v", objectID, err)
+			c.webexClient.Slog().Error("invalid acknowledged message ID",
+				"messageId", objectID, "conversationId", activity.ConversationID(), "error", err)
+			return
+		}
+		message, err := c.Get(messageID)
+		if err != nil {
+			c.webexClient.Slog().Error("error fetching acknowledged message",
+				"messageId", objectID, "conversationId", activity.ConversationID(), "error", err)
 			return
 		}
 
@@ -527,16 +627,29 @@ func (c *Client) activityToMessage(activity *conversation.Activity) (*Message, e
 		return nil, fmt.Errorf("activity is nil")
 	}
 
+	// Convert the activity's UUIDs to REST API IDs
+	messageID, err := c.conversationClient.MessageID(activity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	personID, err := c.conversationClient.PersonID(activity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid person ID: %w", err)
+	}
+
 	// Extract basic message properties
 	message := &Message{
-		ID:       activity.ID,
-		PersonID: activity.Actor.ID,
+		ID:       messageID,
+		PersonID: personID,
 		Created:  parseTime(activity.Published),
 	}
 
 	// Extract room ID from target
 	if activity.Target != nil {
-		message.RoomID = activity.Target.ID
+		message.RoomID, err = c.conversationClient.RoomID(activity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid room ID: %w", err)
+		}
 	}
 
 	// Extract message content - this will use decrypted content if available
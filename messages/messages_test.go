@@ -7,12 +7,15 @@
 package messages
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -695,51 +698,185 @@ func TestCreateWithAdaptiveCard_ValidationError(t *testing.T) {
 	}
 }
 
-// --- resolveFileBytes tests ---
+// --- resolveFile tests ---
 
-func TestResolveFileBytes_RawBytes(t *testing.T) {
+// readUpload reads the content of a resolved upload.
+func readUpload(t *testing.T, upload webexsdk.MultipartFile) []byte {
+	t.Helper()
+	var r io.Reader = bytes.NewReader(upload.Content)
+	switch {
+	case upload.Open != nil:
+		rc, err := upload.Open()
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		defer func() { _ = rc.Close() }()
+		r = rc
+	case upload.Reader != nil:
+		r = upload.Reader
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	return data
+}
+
+func TestResolveFile_RawBytes(t *testing.T) {
 	data := []byte("raw content")
-	result, err := resolveFileBytes(&FileUpload{FileBytes: data})
+	result, err := resolveFile(&FileUpload{FileBytes: data})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if string(result) != "raw content" {
-		t.Errorf("Expected 'raw content', got '%s'", result)
+	if string(readUpload(t, result)) != "raw content" || result.FileName != "attachment" {
+		t.Errorf("Unexpected upload: %+v", result)
 	}
 }
 
-func TestResolveFileBytes_StdBase64(t *testing.T) {
+func TestResolveFile_StdBase64(t *testing.T) {
 	original := "standard base64 content"
 	b64 := base64.StdEncoding.EncodeToString([]byte(original))
-	result, err := resolveFileBytes(&FileUpload{Base64Data: b64})
+	result, err := resolveFile(&FileUpload{Base64Data: b64})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if string(result) != original {
-		t.Errorf("Expected '%s', got '%s'", original, result)
+	if got := readUpload(t, result); string(got) != original {
+		t.Errorf("Expected '%s', got '%s'", original, got)
+	}
+	if result.Size != int64(len(original)) {
+		t.Errorf("Expected decoded size %d, got %d", len(original), result.Size)
+	}
+	// Base64 content can be reopened for retries
+	if got := readUpload(t, result); string(got) != original {
+		t.Errorf("Expected reopened content '%s', got '%s'", original, got)
 	}
 }
 
-func TestResolveFileBytes_URLBase64(t *testing.T) {
+func TestResolveFile_URLBase64(t *testing.T) {
 	// Use content that produces + and / in standard base64
 	original := []byte{0xfb, 0xff, 0xfe}
 	b64 := base64.URLEncoding.EncodeToString(original)
-	result, err := resolveFileBytes(&FileUpload{Base64Data: b64})
+	result, err := resolveFile(&FileUpload{Base64Data: b64})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if string(result) != string(original) {
+	if string(readUpload(t, result)) != string(original) {
 		t.Errorf("URL-safe base64 decode mismatch")
 	}
 }
 
-func TestResolveFileBytes_NoData(t *testing.T) {
-	_, err := resolveFileBytes(&FileUpload{FileName: "empty.txt"})
+func TestResolveFile_FilePath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.7"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	result, err := resolveFile(&FileUpload{FilePath: path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.FileName != "report.pdf" || result.Size != 8 || result.Open == nil {
+		t.Errorf("Unexpected upload: %+v", result)
+	}
+	if string(readUpload(t, result)) != "%PDF-1.7" {
+		t.Error("File content mismatch")
+	}
+
+	if _, err := resolveFile(&FileUpload{FilePath: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestResolveFile_NoData(t *testing.T) {
+	_, err := resolveFile(&FileUpload{FileName: "empty.txt"})
 	if err == nil {
 		t.Error("Expected error for empty file data")
 	}
 }
 
+func TestCreateWithAttachment_Streaming(t *testing.T) {
+	content := strings.Repeat("video-frame ", 50000)
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Failed to parse multipart: %v", err)
+		}
+		file, _, err := r.FormFile("files")
+		if err != nil {
+			t.Fatalf("Failed to get uploaded file: %v", err)
+		}
+		body, _ := io.ReadAll(file)
+		_ = file.Close()
+		if string(body) != content {
+			t.Errorf("Uploaded content mismatch: got %d bytes", len(body))
+		}
+		if r.ContentLength <= int64(len(content)) {
+			t.Errorf("Expected Content-Length for known size, got %d", r.ContentLength)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Message{ID: "msg-stream"})
+	}))
+	defer server.Close()
+
+	mc := newTestClient(t, server)
+	mc.webexClient.Config.MaxRetries = 1
+	mc.webexClient.Config.RetryBaseDelay = time.Millisecond
+	opens := 0
+	var lastSent, lastTotal int64
+	result, err := mc.CreateWithAttachment(&Message{RoomID: "room"}, &FileUpload{
+		FileName: "video.mp4",
+		Open: func() (io.ReadCloser, error) {
+			opens++
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+		Size:     int64(len(content)),
+		Progress: func(sent, total int64) { lastSent, lastTotal = sent, total },
+	})
+	if err != nil {
+		t.Fatalf("CreateWithAttachment failed: %v", err)
+	}
+	if result.ID != "msg-stream" {
+		t.Errorf("Expected ID 'msg-stream', got '%s'", result.ID)
+	}
+	if opens != 2 || attempts != 2 {
+		t.Errorf("Expected the file to be reopened for the retry, got %d opens and %d attempts", opens, attempts)
+	}
+	if lastSent != int64(len(content)) || lastTotal != int64(len(content)) {
+		t.Errorf("Expected final progress %d/%d, got %d/%d", len(content), len(content), lastSent, lastTotal)
+	}
+}
+
+func TestCreateWithAttachment_ReaderNotRetried(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.ContentLength != -1 {
+			t.Errorf("Expected chunked upload for unknown size, got Content-Length %d", r.ContentLength)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	mc := newTestClient(t, server)
+	mc.webexClient.Config.MaxRetries = 3
+	mc.webexClient.Config.RetryPolicy = &webexsdk.RetryPolicy{RetryNonIdempotent: true}
+	mc.webexClient.Config.RetryBaseDelay = time.Millisecond
+	_, err := mc.CreateWithAttachment(&Message{RoomID: "room"}, &FileUpload{
+		FileName: "log.txt",
+		Reader:   strings.NewReader("streamed once"),
+	})
+	if err == nil {
+		t.Error("Expected error for 503")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected a single attempt for a Reader upload, got %d", attempts)
+	}
+}
+
 // --- NewAdaptiveCard tests ---
 
 func TestNewAdaptiveCard(t *testing.T) {
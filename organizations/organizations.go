@@ -0,0 +1,137 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// Organization represents a Webex organization
+type Organization struct {
+	ID                       string                  `json:"id,omitempty"`
+	DisplayName              string                  `json:"displayName,omitempty"`
+	Created                  *time.Time              `json:"created,omitempty"`
+	XsiActionEndpoint        string                  `json:"xsiActionEndpoint,omitempty"`
+	XsiEventsEndpoint        string                  `json:"xsiEventsEndpoint,omitempty"`
+	XsiEventsChannelEndpoint string                  `json:"xsiEventsChannelEndpoint,omitempty"`
+	XsiDomain                string                  `json:"xsiDomain,omitempty"`
+	Errors                   webexsdk.ResourceErrors `json:"errors,omitempty"`
+}
+
+// GetOptions contains the options for getting an organization
+type GetOptions struct {
+	// CallingData includes the XSI endpoints of Webex Calling organizations
+	CallingData bool `url:"callingData,omitempty"`
+}
+
+// OrganizationsPage represents a paginated list of organizations
+type OrganizationsPage = webexsdk.TypedPage[Organization]
+
+// Config holds the configuration for the Organizations plugin
+type Config struct {
+	// Any configuration settings for the organizations plugin can go here
+}
+
+// DefaultConfig returns the default configuration for the Organizations plugin
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Client is the organizations API client
+type Client struct {
+	webexClient *webexsdk.Client
+	config      *Config
+}
+
+// New creates a new Organizations plugin
+func New(webexClient *webexsdk.Client, config *Config) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &Client{
+		webexClient: webexClient,
+		config:      config,
+	}
+}
+
+// List returns the organizations visible to the authenticated admin: their
+// own organization, or every managed organization for partner admins
+func (c *Client) List() (*OrganizationsPage, error) {
+	return c.ListCtx(context.Background())
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context) (*OrganizationsPage, error) {
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "organizations", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := webexsdk.NewPage(resp, c.webexClient, webexsdk.ResourceOrganizations)
+	if err != nil {
+		return nil, err
+	}
+
+	return webexsdk.NewTypedPage[Organization](page)
+}
+
+// ListAll returns an Iterator over every Organization, following pagination
+// links across pages. Use Limit on the returned Iterator to cap the number
+// of items.
+func (c *Client) ListAll(ctx context.Context) *webexsdk.Iterator[Organization] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Organization, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.Page, nil
+	})
+}
+
+// All returns a range-over-func sequence of every Organization.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context) iter.Seq2[Organization, error] {
+	return c.ListAll(ctx).All()
+}
+
+// Get returns details for an organization
+func (c *Client) Get(orgID string) (*Organization, error) {
+	return c.GetCtx(context.Background(), orgID, nil)
+}
+
+// GetCtx is like Get but uses ctx for the request and accepts options.
+func (c *Client) GetCtx(ctx context.Context, orgID string, options *GetOptions) (*Organization, error) {
+	if orgID == "" {
+		return nil, fmt.Errorf("orgID is required")
+	}
+
+	params := url.Values{}
+	if options != nil && options.CallingData {
+		params.Set("callingData", "true")
+	}
+
+	path := fmt.Sprintf("organizations/%s", orgID)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var org Organization
+	if err := webexsdk.ParseResponse(resp, &org); err != nil {
+		return nil, err
+	}
+
+	return &org, nil
+}
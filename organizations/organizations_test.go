@@ -0,0 +1,102 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	baseURL, _ := url.Parse(server.URL)
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.BaseURL = baseURL
+
+	return New(client, nil), server
+}
+
+func TestListAll(t *testing.T) {
+	var serverURL string
+	orgsPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations" {
+			t.Errorf("Expected path '/organizations', got '%s'", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		items := []Organization{{ID: "org-1", DisplayName: "Acme"}}
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/organizations?cursor=2>; rel="next"`, serverURL))
+		} else {
+			items = []Organization{{ID: "org-2", DisplayName: "Acme Subsidiary"}}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	})
+	defer server.Close()
+	serverURL = server.URL
+
+	orgs, err := orgsPlugin.ListAll(context.Background()).Collect()
+	if err != nil {
+		t.Fatalf("Failed to list organizations: %v", err)
+	}
+	if len(orgs) != 2 || orgs[0].ID != "org-1" || orgs[1].ID != "org-2" {
+		t.Errorf("Expected organizations from both pages, got %+v", orgs)
+	}
+}
+
+func TestGet(t *testing.T) {
+	orgsPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/org-1" {
+			t.Errorf("Expected path '/organizations/org-1', got '%s'", r.URL.Path)
+		}
+
+		org := Organization{ID: "org-1", DisplayName: "Acme"}
+		if r.URL.Query().Get("callingData") == "true" {
+			org.XsiDomain = "xsi.example.com"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(org)
+	})
+	defer server.Close()
+
+	org, err := orgsPlugin.Get("org-1")
+	if err != nil {
+		t.Fatalf("Failed to get organization: %v", err)
+	}
+	if org.DisplayName != "Acme" || org.XsiDomain != "" {
+		t.Errorf("Unexpected organization: %+v", org)
+	}
+
+	org, err = orgsPlugin.GetCtx(context.Background(), "org-1", &GetOptions{CallingData: true})
+	if err != nil {
+		t.Fatalf("Failed to get organization: %v", err)
+	}
+	if org.XsiDomain != "xsi.example.com" {
+		t.Errorf("Expected calling data, got %+v", org)
+	}
+
+	if _, err := orgsPlugin.Get(""); err == nil {
+		t.Error("Expected error for empty orgID")
+	}
+}
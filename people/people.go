@@ -7,8 +7,9 @@
 package people
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"sync"
@@ -51,10 +52,7 @@ type ListOptions struct {
 }
 
 // PeoplePage represents a paginated list of people
-type PeoplePage struct {
-	Items []Person `json:"items"`
-	*webexsdk.Page
-}
+type PeoplePage = webexsdk.TypedPage[Person]
 
 // Config holds the configuration for the People plugin
 type Config struct {
@@ -121,7 +119,10 @@ func NewBatcher(client *webexsdk.Client, config *Config) *Batcher {
 	return batcher
 }
 
-// Request adds a request to the batch and returns the result
+// Request adds a request to the batch and returns the result.
+// It does not take a context: the batch is fetched once for all of its
+// callers, so no single caller's context can cancel it. Use
+// BatchRequestCtx for context-aware lookups.
 func (b *Batcher) Request(id string) (*Person, error) {
 	hydraID := InferPersonIDFromUUID(id)
 	b.mu.Lock()
@@ -155,6 +156,11 @@ func (b *Batcher) Request(id string) (*Person, error) {
 
 // BatchRequest processes a batch of requests immediately
 func (b *Batcher) BatchRequest(ids []string) ([]Person, error) {
+	return b.BatchRequestCtx(context.Background(), ids)
+}
+
+// BatchRequestCtx is like BatchRequest but uses ctx for the request.
+func (b *Batcher) BatchRequestCtx(ctx context.Context, ids []string) ([]Person, error) {
 	// For empty list, return empty result
 	if len(ids) == 0 {
 		return []Person{}, nil
@@ -177,7 +183,7 @@ func (b *Batcher) BatchRequest(ids []string) ([]Person, error) {
 		idParam.Add("showAllTypes", "true")
 	}
 
-	resp, err := b.webexClient.Request(http.MethodGet, "people", idParam, nil)
+	resp, err := b.webexClient.RequestWithRetry(ctx, http.MethodGet, "people", idParam, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +196,9 @@ func (b *Batcher) BatchRequest(ids []string) ([]Person, error) {
 	return batchResp.Items, nil
 }
 
-// processBatch processes the batch of requests
+// processBatch processes the batch of requests. The request is shared by
+// every caller in the batch and is therefore sent without a caller's
+// context.
 func (b *Batcher) processBatch() {
 	// Wait for timer to expire
 	<-b.timer.C
@@ -304,18 +312,23 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Get returns a single person by ID
 func (c *Client) Get(personID string) (*Person, error) {
+	return c.GetCtx(context.Background(), personID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, personID string) (*Person, error) {
 	if personID == "" {
 		return nil, fmt.Errorf("person ID is required")
 	}
 
 	if personID == "me" {
-		return c.getMe()
+		return c.getMe(ctx)
 	}
 
 	// For single person retrieval, use direct API call with path parameter
 	// instead of using the batcher
 	path := fmt.Sprintf("people/%s", personID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -329,8 +342,8 @@ func (c *Client) Get(personID string) (*Person, error) {
 }
 
 // getMe fetches the current user from the /people/me endpoint
-func (c *Client) getMe() (*Person, error) {
-	resp, err := c.webexClient.Request(http.MethodGet, "people/me", nil, nil)
+func (c *Client) getMe(ctx context.Context) (*Person, error) {
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "people/me", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -345,14 +358,24 @@ func (c *Client) getMe() (*Person, error) {
 
 // GetMe returns the current authenticated user
 func (c *Client) GetMe() (*Person, error) {
-	return c.getMe()
+	return c.GetMeCtx(context.Background())
+}
+
+// GetMeCtx is like GetMe but uses ctx for the request.
+func (c *Client) GetMeCtx(ctx context.Context) (*Person, error) {
+	return c.getMe(ctx)
 }
 
 // List returns a list of people
 func (c *Client) List(options *ListOptions) (*PeoplePage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*PeoplePage, error) {
 	// Handle batch request if IDs are provided
 	if options != nil && len(options.IDs) > 0 {
-		persons, err := c.batcher.BatchRequest(options.IDs)
+		persons, err := c.batcher.BatchRequestCtx(ctx, options.IDs)
 		if err != nil {
 			return nil, err
 		}
@@ -379,7 +402,7 @@ func (c *Client) List(options *ListOptions) (*PeoplePage, error) {
 		}
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "people", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "people", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -389,19 +412,24 @@ func (c *Client) List(options *ListOptions) (*PeoplePage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into People
-	peoplePage := &PeoplePage{
-		Page:  page,
-		Items: make([]Person, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Person](page)
+}
 
-	for i, item := range page.Items {
-		var person Person
-		if err := json.Unmarshal(item, &person); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Person matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Person] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Person, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		peoplePage.Items[i] = person
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return peoplePage, nil
+// All returns a range-over-func sequence of every Person matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Person, error] {
+	return c.ListAll(ctx, options).All()
 }
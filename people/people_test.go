@@ -7,14 +7,18 @@
 package people
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/WebexCommunity/webex-go-sdk/v2/conversation"
 	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 )
 
 func TestGet(t *testing.T) {
@@ -168,12 +172,17 @@ func TestInferPersonIDFromUUID(t *testing.T) {
 		{
 			name:     "Regular UUID",
 			input:    "12345678-1234-1234-1234-123456789012",
-			expected: EncodeBase64("ciscospark://us/PEOPLE/12345678-1234-1234-1234-123456789012"),
+			expected: "Y2lzY29zcGFyazovL3VzL1BFT1BMRS8xMjM0NTY3OC0xMjM0LTEyMzQtMTIzNC0xMjM0NTY3ODkwMTI",
 		},
 		{
-			name:     "Already encoded Hydra ID",
-			input:    EncodeBase64("ciscospark://us/PEOPLE/12345678-1234-1234-1234-123456789012"),
-			expected: EncodeBase64("ciscospark://us/PEOPLE/12345678-1234-1234-1234-123456789012"),
+			name:     "Padded Hydra ID",
+			input:    "Y2lzY29zcGFyazovL3VzL1BFT1BMRS8xMjM0NTY3OC0xMjM0LTEyMzQtMTIzNC0xMjM0NTY3ODkwMTI=",
+			expected: "Y2lzY29zcGFyazovL3VzL1BFT1BMRS8xMjM0NTY3OC0xMjM0LTEyMzQtMTIzNC0xMjM0NTY3ODkwMTI=",
+		},
+		{
+			name:     "Unpadded Hydra ID",
+			input:    "Y2lzY29zcGFyazovL3VzL1BFT1BMRS8xMjM0NTY3OC0xMjM0LTEyMzQtMTIzNC0xMjM0NTY3ODkwMTI",
+			expected: "Y2lzY29zcGFyazovL3VzL1BFT1BMRS8xMjM0NTY3OC0xMjM0LTEyMzQtMTIzNC0xMjM0NTY3ODkwMTI",
 		},
 	}
 
@@ -187,6 +196,44 @@ func TestInferPersonIDFromUUID(t *testing.T) {
 	}
 }
 
+func TestBase64MatchesInferredPersonID(t *testing.T) {
+	uri := "ciscospark://us/PEOPLE/12345678-1234-1234-1234-123456789012"
+	inferred := InferPersonIDFromUUID("12345678-1234-1234-1234-123456789012")
+
+	if encoded := EncodeBase64(uri); encoded != inferred {
+		t.Errorf("Expected EncodeBase64 to match InferPersonIDFromUUID, got %q and %q", encoded, inferred)
+	}
+	for _, id := range []string{inferred, inferred + "="} {
+		decoded, err := DecodeBase64(id)
+		if err != nil || decoded != uri {
+			t.Errorf("DecodeBase64(%q) = %q, %v; expected %q", id, decoded, err, uri)
+		}
+	}
+	if _, err := DecodeBase64("not base64!"); err == nil {
+		t.Error("Expected an error for invalid base64")
+	}
+}
+
+func TestInferPersonIDMatchesActivityPersonID(t *testing.T) {
+	uuid := "12345678-1234-1234-1234-123456789012"
+
+	client, _ := webexsdk.NewClient("test-token", nil)
+	fromActivity, err := conversation.New(client, nil).PersonID(&conversation.Activity{
+		Actor: &conversation.Actor{ID: uuid},
+	})
+	if err != nil {
+		t.Fatalf("PersonID failed: %v", err)
+	}
+
+	inferred := InferPersonIDFromUUID(uuid)
+	if inferred != fromActivity {
+		t.Errorf("Expected the same ID from both paths, got %q and %q", inferred, fromActivity)
+	}
+	if encoded, _ := ids.Encode(ids.ClusterUS, ids.KindPeople, uuid); inferred != encoded {
+		t.Errorf("Expected %q, got %q", encoded, inferred)
+	}
+}
+
 func TestGetMe(t *testing.T) {
 	// Create a test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -410,6 +457,19 @@ func TestBatchRequest_ServerError(t *testing.T) {
 	}
 }
 
+func TestListCtx_IDsUseContext(t *testing.T) {
+	people := []Person{{ID: InferPersonIDFromUUID("id-1"), DisplayName: "Alice"}}
+	server, client := newTestPeopleServer(t, people)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := New(client, nil).ListCtx(ctx, &ListOptions{IDs: []string{"id-1"}})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
 func TestBatcher_AsyncRequest(t *testing.T) {
 	people := []Person{
 		{ID: InferPersonIDFromUUID("uuid-1"), DisplayName: "Alice"},
@@ -8,32 +8,48 @@ package people
 
 import (
 	"encoding/base64"
-	"fmt"
-	"strings"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk/ids"
 )
 
-// EncodeBase64 encodes a string to base64
+// EncodeBase64 encodes a string to unpadded, URL-safe base64, the encoding
+// of Webex IDs and of InferPersonIDFromUUID.
 func EncodeBase64(s string) string {
-	return base64.StdEncoding.EncodeToString([]byte(s))
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
 }
 
-// DecodeBase64 decodes a base64 string
+// DecodeBase64 decodes a base64 string. It accepts the standard and
+// URL-safe alphabets, with or without padding.
 func DecodeBase64(s string) (string, error) {
-	decoded, err := base64.StdEncoding.DecodeString(s)
-	if err != nil {
-		return "", err
+	var err error
+	for _, enc := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.RawStdEncoding,
+		base64.URLEncoding,
+		base64.StdEncoding,
+	} {
+		var decoded []byte
+		if decoded, err = enc.DecodeString(s); err == nil {
+			return string(decoded), nil
+		}
 	}
-	return string(decoded), nil
+	return "", err
 }
 
-// InferPersonIDFromUUID converts a UUID to a Hydra ID without a network call
+// InferPersonIDFromUUID converts a UUID to a Hydra ID without a network call.
+// It encodes IDs as the webexsdk/ids package does, so the result matches the
+// person IDs of conversation activities and received messages.
 func InferPersonIDFromUUID(id string) string {
-	// Check if already a Hydra ID
-	decodedID, err := DecodeBase64(id)
-	if err == nil && strings.Contains(decodedID, "ciscospark://") {
+	// Check if already a Hydra ID, in any base64 variant
+	if _, err := ids.Parse(id); err == nil {
 		return id
 	}
 
 	// Convert UUID to Hydra ID
-	return EncodeBase64(fmt.Sprintf("ciscospark://us/PEOPLE/%s", id))
+	if hydraID, err := ids.Encode(ids.ClusterUS, ids.KindPeople, id); err == nil {
+		return hydraID
+	}
+
+	// Not a canonical UUID: encode it as is
+	return ids.New(ids.KindPeople, id).String()
 }
@@ -0,0 +1,342 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webex
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// PoolConfig holds the configuration for a Pool.
+type PoolConfig struct {
+	// Config is the template for the configuration of every tenant's
+	// client. Each tenant gets a copy, so Config fields such as MaxRetries
+	// apply per tenant. Pointer fields (CircuitBreaker, Cache, Tracer, ...)
+	// are shared by all tenants. RateLimiter is replaced by the tenant's own
+	// limiter (see TenantRateLimit). If nil, webexsdk.DefaultConfig is used.
+	Config *webexsdk.Config
+
+	// HTTPClient is shared by every tenant so that they reuse one
+	// connection pool. If nil, Config.HttpClient is used, or a client is
+	// created with Config.Timeout and room for MaxIdleConnsPerHost idle
+	// connections to the API host.
+	HTTPClient *http.Client
+
+	// MaxIdleConnsPerHost sizes the connection pool of the HTTPClient
+	// created by the Pool. Default: 100.
+	MaxIdleConnsPerHost int
+
+	// GlobalRateLimiter throttles the requests of all tenants together,
+	// e.g. to cap the total number of requests in flight. If nil, tenants
+	// are only limited individually.
+	GlobalRateLimiter *webexsdk.RateLimiter
+
+	// TenantRateLimit configures the RateLimiter each tenant gets, with
+	// GlobalRateLimiter as its parent. A 429 response pauses only the
+	// tenant that received it. If nil, tenants are not throttled
+	// individually but 429 responses still pause the tenant.
+	TenantRateLimit *webexsdk.RateLimiterConfig
+
+	// IdleTimeout is how long a tenant may go without being fetched from
+	// the Pool before it is evicted. Tenants with a connected Mercury
+	// WebSocket are never idle. Zero means tenants are not evicted for
+	// being idle.
+	IdleTimeout time.Duration
+
+	// MaxTenants caps the number of tenants; fetching a new tenant beyond
+	// it evicts the least recently used one. Zero means no cap.
+	MaxTenants int
+
+	// OnEvict, if set, is called after a tenant has been evicted and shut
+	// down, with the error returned by WebexClient.Close.
+	OnEvict func(tenantID string, err error)
+}
+
+// Pool hands out a WebexClient per tenant, e.g. per organization or bot,
+// each with its own token. All tenants share one HTTP connection pool and
+// an optional global rate limiter, while retries and 429 pauses stay
+// isolated per tenant. Evicted tenants are shut down, which disconnects
+// any Mercury connection they opened.
+//
+// A Pool is safe for concurrent use. Close it when done.
+type Pool struct {
+	config     *PoolConfig
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	tenants map[string]*tenant
+	closed  bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// tenant is a client in a Pool.
+type tenant struct {
+	client   *WebexClient
+	token    string // access token, if created by Client
+	lastUsed time.Time
+}
+
+// ErrPoolClosed is returned when fetching a client from a closed Pool.
+var ErrPoolClosed = errors.New("webex: pool is closed")
+
+// NewPool creates a new Pool.
+func NewPool(config *PoolConfig) *Pool {
+	if config == nil {
+		config = &PoolConfig{}
+	}
+	if config.Config == nil {
+		config.Config = webexsdk.DefaultConfig()
+	}
+	if config.MaxIdleConnsPerHost <= 0 {
+		config.MaxIdleConnsPerHost = 100
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = config.Config.HttpClient
+	}
+	if httpClient == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.MaxIdleConns = max(transport.MaxIdleConns, config.MaxIdleConnsPerHost)
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+		timeout := config.Config.Timeout
+		if timeout <= 0 {
+			timeout = webexsdk.DefaultConfig().Timeout
+		}
+		httpClient = &http.Client{Timeout: timeout, Transport: transport}
+	}
+
+	p := &Pool{
+		config:     config,
+		httpClient: httpClient,
+		tenants:    make(map[string]*tenant),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	if config.IdleTimeout > 0 {
+		go p.evictIdle()
+	} else {
+		close(p.done)
+	}
+	return p
+}
+
+// Client returns the client of tenantID, creating it with accessToken on
+// first use. If the tenant exists with a different access token, e.g.
+// after the token was rotated, its client is shut down and replaced.
+func (p *Pool) Client(tenantID, accessToken string) (*WebexClient, error) {
+	if accessToken == "" {
+		return nil, fmt.Errorf("accessToken is required")
+	}
+	return p.client(tenantID, accessToken, func() webexsdk.TokenSource {
+		return webexsdk.StaticTokenSource(accessToken)
+	})
+}
+
+// ClientWithTokenSource returns the client of tenantID, creating it with
+// tokenSource on first use. Use it for OAuth integrations whose tokens
+// are refreshed; an existing tenant keeps its original TokenSource.
+func (p *Pool) ClientWithTokenSource(tenantID string, tokenSource webexsdk.TokenSource) (*WebexClient, error) {
+	if tokenSource == nil {
+		return nil, fmt.Errorf("token source cannot be nil")
+	}
+	return p.client(tenantID, "", func() webexsdk.TokenSource { return tokenSource })
+}
+
+// client implements Client and ClientWithTokenSource.
+func (p *Pool) client(tenantID, token string, tokenSource func() webexsdk.TokenSource) (*WebexClient, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenantID is required")
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+
+	var evicted map[string]*tenant
+	if t, ok := p.tenants[tenantID]; ok {
+		if t.token == token || token == "" {
+			t.lastUsed = time.Now()
+			p.mu.Unlock()
+			return t.client, nil
+		}
+		// The token was rotated
+		delete(p.tenants, tenantID)
+		evicted = map[string]*tenant{tenantID: t}
+	}
+
+	client, err := NewClientWithTokenSource(tokenSource(), p.tenantConfig())
+	if err != nil {
+		p.mu.Unlock()
+		p.shutdown(evicted)
+		return nil, err
+	}
+	p.tenants[tenantID] = &tenant{client: client, token: token, lastUsed: time.Now()}
+
+	if limit := p.config.MaxTenants; limit > 0 && len(p.tenants) > limit {
+		id, lru := p.leastRecentlyUsed()
+		delete(p.tenants, id)
+		if evicted == nil {
+			evicted = make(map[string]*tenant)
+		}
+		evicted[id] = lru
+	}
+	p.mu.Unlock()
+
+	p.shutdown(evicted)
+	return client, nil
+}
+
+// tenantConfig returns a copy of the template config for a new tenant.
+func (p *Pool) tenantConfig() *webexsdk.Config {
+	config := *p.config.Config
+	config.HttpClient = p.httpClient
+	config.DefaultHeaders = maps.Clone(config.DefaultHeaders)
+	if config.DefaultHeaders == nil {
+		config.DefaultHeaders = make(map[string]string)
+	}
+
+	var limit webexsdk.RateLimiterConfig
+	if p.config.TenantRateLimit != nil {
+		limit = *p.config.TenantRateLimit
+		limit.Resources = maps.Clone(limit.Resources)
+	}
+	limit.Parent = p.config.GlobalRateLimiter
+	config.RateLimiter = webexsdk.NewRateLimiter(&limit)
+	return &config
+}
+
+// leastRecentlyUsed returns the tenant fetched longest ago. p.mu must be
+// held.
+func (p *Pool) leastRecentlyUsed() (string, *tenant) {
+	var oldestID string
+	var oldest *tenant
+	for id, t := range p.tenants {
+		if oldest == nil || t.lastUsed.Before(oldest.lastUsed) {
+			oldestID, oldest = id, t
+		}
+	}
+	return oldestID, oldest
+}
+
+// Remove shuts down the client of tenantID and removes it from the pool.
+// It returns false if there is no such tenant.
+func (p *Pool) Remove(tenantID string) (bool, error) {
+	p.mu.Lock()
+	t, ok := p.tenants[tenantID]
+	delete(p.tenants, tenantID)
+	p.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+	return true, t.client.Close()
+}
+
+// Len returns the number of tenants in the pool.
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.tenants)
+}
+
+// Tenants returns the IDs of the tenants in the pool.
+func (p *Pool) Tenants() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ids := make([]string, 0, len(p.tenants))
+	for id := range p.tenants {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Close shuts down every tenant and stops idle eviction. Clients fetched
+// afterwards fail with ErrPoolClosed. It returns the errors from shutting
+// down the tenants, joined.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	tenants := p.tenants
+	p.tenants = make(map[string]*tenant)
+	p.mu.Unlock()
+
+	if p.config.IdleTimeout > 0 {
+		close(p.stop)
+	}
+	<-p.done
+
+	var errs []error
+	for id, t := range tenants {
+		if err := t.client.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// evictIdle periodically evicts idle tenants until the pool is closed.
+func (p *Pool) evictIdle() {
+	defer close(p.done)
+	ticker := time.NewTicker(max(p.config.IdleTimeout/2, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			p.shutdown(p.takeIdle(now))
+		}
+	}
+}
+
+// takeIdle removes and returns the tenants idle at now.
+func (p *Pool) takeIdle(now time.Time) map[string]*tenant {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var idle map[string]*tenant
+	for id, t := range p.tenants {
+		if now.Sub(t.lastUsed) < p.config.IdleTimeout {
+			continue
+		}
+		if mc := t.client.openedMercury(); mc != nil && mc.IsConnected() {
+			continue
+		}
+		if idle == nil {
+			idle = make(map[string]*tenant)
+		}
+		idle[id] = t
+		delete(p.tenants, id)
+	}
+	return idle
+}
+
+// shutdown closes evicted tenants and reports them to OnEvict.
+func (p *Pool) shutdown(evicted map[string]*tenant) {
+	for id, t := range evicted {
+		err := t.client.Close()
+		if p.config.OnEvict != nil {
+			p.config.OnEvict(id, err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func TestPoolSharesTransportAndIsolatesTenants(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token-a" {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	pool := NewPool(&PoolConfig{
+		Config:            &webexsdk.Config{BaseURL: server.URL},
+		HTTPClient:        server.Client(),
+		GlobalRateLimiter: webexsdk.NewRateLimiter(&webexsdk.RateLimiterConfig{MaxInFlight: 4}),
+	})
+	defer func() { _ = pool.Close() }()
+
+	a, err := pool.Client("org-a", "token-a")
+	if err != nil {
+		t.Fatalf("Failed to get client: %v", err)
+	}
+	b, err := pool.Client("org-b", "token-b")
+	if err != nil {
+		t.Fatalf("Failed to get client: %v", err)
+	}
+	if again, _ := pool.Client("org-a", "token-a"); again != a {
+		t.Error("Expected the same client for the same tenant")
+	}
+	if a.Core().GetHTTPClient() != b.Core().GetHTTPClient() {
+		t.Error("Expected tenants to share the HTTP client")
+	}
+	if a.Core().Config == b.Core().Config {
+		t.Error("Expected tenants to have their own Config")
+	}
+
+	// A 429 for one tenant does not pause the other
+	ctx := context.Background()
+	if _, err := a.Rooms().ListCtx(ctx, nil); err == nil {
+		t.Fatal("Expected 429 error for tenant a")
+	}
+	if a.Core().Config.RateLimiter.PausedUntil(webexsdk.ResourceRooms).IsZero() {
+		t.Error("Expected tenant a to be paused")
+	}
+	start := time.Now()
+	if _, err := b.Rooms().ListCtx(ctx, nil); err != nil {
+		t.Fatalf("Unexpected error for tenant b: %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Expected tenant b not to wait for tenant a's pause")
+	}
+}
+
+func TestPoolEviction(t *testing.T) {
+	var mu sync.Mutex
+	var evicted []string
+	pool := NewPool(&PoolConfig{
+		MaxTenants: 2,
+		OnEvict: func(tenantID string, err error) {
+			mu.Lock()
+			evicted = append(evicted, tenantID)
+			mu.Unlock()
+		},
+	})
+	defer func() { _ = pool.Close() }()
+
+	a, _ := pool.Client("a", "token-a")
+	_, _ = pool.Client("b", "token-b")
+	_, _ = pool.Client("a", "token-a") // a is now more recent than b
+	_, _ = pool.Client("c", "token-c")
+
+	if pool.Len() != 2 {
+		t.Errorf("Expected 2 tenants, got %d", pool.Len())
+	}
+	mu.Lock()
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("Expected b to be evicted, got %v", evicted)
+	}
+	mu.Unlock()
+
+	// A rotated token replaces the tenant's client
+	rotated, _ := pool.Client("a", "token-a2")
+	if rotated == a {
+		t.Error("Expected a new client after token rotation")
+	}
+
+	if ok, err := pool.Remove("c"); !ok || err != nil {
+		t.Errorf("Expected c to be removed, got %v, %v", ok, err)
+	}
+	if ok, _ := pool.Remove("c"); ok {
+		t.Error("Expected second Remove to report no tenant")
+	}
+}
+
+func TestPoolIdleEvictionAndClose(t *testing.T) {
+	evictedCh := make(chan string, 1)
+	pool := NewPool(&PoolConfig{
+		IdleTimeout: 20 * time.Millisecond,
+		OnEvict:     func(tenantID string, err error) { evictedCh <- tenantID },
+	})
+
+	client, _ := pool.Client("idle", "token")
+	client.Mercury() // created but never connected, so the tenant can go idle
+
+	select {
+	case id := <-evictedCh:
+		if id != "idle" {
+			t.Errorf("Expected idle tenant to be evicted, got %s", id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected idle tenant to be evicted")
+	}
+	if pool.Len() != 0 {
+		t.Errorf("Expected empty pool, got %d tenants", pool.Len())
+	}
+
+	if err := pool.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := pool.Client("new", "token"); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Expected ErrPoolClosed, got %v", err)
+	}
+}
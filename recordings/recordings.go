@@ -7,9 +7,10 @@
 package recordings
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 
@@ -59,8 +60,14 @@ type DownloadedContent struct {
 	ContentDisposition string
 	// ContentLength is the size in bytes (-1 if unknown).
 	ContentLength int64
-	// Data is the raw file content.
+	// Data is the raw file content. It is nil for the streaming
+	// DownloadTo and DownloadToFile methods.
 	Data []byte
+	// FileName is the filename from ContentDisposition, reduced to its base
+	// name (set by DownloadTo and DownloadToFile).
+	FileName string
+	// Path is the file written by DownloadToFile.
+	Path string
 }
 
 // ListOptions contains the options for listing recordings
@@ -79,10 +86,7 @@ type ListOptions struct {
 }
 
 // RecordingsPage represents a paginated list of recordings
-type RecordingsPage struct {
-	Items []Recording `json:"items"`
-	*webexsdk.Page
-}
+type RecordingsPage = webexsdk.TypedPage[Recording]
 
 // Config holds the configuration for the Recordings plugin
 type Config struct{}
@@ -112,6 +116,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 // List returns a list of recordings.
 // Use ListOptions to filter by meetingId, date range, host, etc.
 func (c *Client) List(options *ListOptions) (*RecordingsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*RecordingsPage, error) {
 	params := url.Values{}
 
 	if options != nil {
@@ -150,7 +159,7 @@ func (c *Client) List(options *ListOptions) (*RecordingsPage, error) {
 		}
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "recordings", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "recordings", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,31 +169,42 @@ func (c *Client) List(options *ListOptions) (*RecordingsPage, error) {
 		return nil, err
 	}
 
-	recordingsPage := &RecordingsPage{
-		Page:  page,
-		Items: make([]Recording, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Recording](page)
+}
 
-	for i, item := range page.Items {
-		var recording Recording
-		if err := json.Unmarshal(item, &recording); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Recording matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Recording] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Recording, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		recordingsPage.Items[i] = recording
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return recordingsPage, nil
+// All returns a range-over-func sequence of every Recording matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Recording, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Get returns details for a single recording, including temporary direct download links
 // for the video, audio, and transcript files.
 func (c *Client) Get(recordingID string) (*Recording, error) {
+	return c.GetCtx(context.Background(), recordingID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, recordingID string) (*Recording, error) {
 	if recordingID == "" {
 		return nil, fmt.Errorf("recordingID is required")
 	}
 
 	path := fmt.Sprintf("recordings/%s", recordingID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -199,12 +219,17 @@ func (c *Client) Get(recordingID string) (*Recording, error) {
 
 // Delete deletes a recording
 func (c *Client) Delete(recordingID string) error {
+	return c.DeleteCtx(context.Background(), recordingID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, recordingID string) error {
 	if recordingID == "" {
 		return fmt.Errorf("recordingID is required")
 	}
 
 	path := fmt.Sprintf("recordings/%s", recordingID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -221,7 +246,12 @@ func (c *Client) Delete(recordingID string) error {
 // The link expires after a short period (check TemporaryDownloadLinks.Expiration).
 // Returns the audio download URL and the full recording object.
 func (c *Client) GetAudioDownloadLink(recordingID string) (string, *Recording, error) {
-	recording, err := c.Get(recordingID)
+	return c.GetAudioDownloadLinkCtx(context.Background(), recordingID)
+}
+
+// GetAudioDownloadLinkCtx is like GetAudioDownloadLink but uses ctx for the request.
+func (c *Client) GetAudioDownloadLinkCtx(ctx context.Context, recordingID string) (string, *Recording, error) {
+	recording, err := c.GetCtx(ctx, recordingID)
 	if err != nil {
 		return "", nil, err
 	}
@@ -240,17 +270,22 @@ func (c *Client) GetAudioDownloadLink(recordingID string) (string, *Recording, e
 // DownloadAudio downloads the audio (MP3) content of a recording.
 // This first fetches the temporary download link, then downloads the audio file.
 func (c *Client) DownloadAudio(recordingID string) (*DownloadedContent, error) {
-	audioURL, _, err := c.GetAudioDownloadLink(recordingID)
+	return c.DownloadAudioCtx(context.Background(), recordingID)
+}
+
+// DownloadAudioCtx is like DownloadAudio but uses ctx for the request.
+func (c *Client) DownloadAudioCtx(ctx context.Context, recordingID string) (*DownloadedContent, error) {
+	audioURL, _, err := c.GetAudioDownloadLinkCtx(ctx, recordingID)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.downloadFromURL(audioURL)
+	return c.downloadFromURL(ctx, audioURL)
 }
 
 // getDownloadLink retrieves a specific download link from a recording.
-func (c *Client) getDownloadLink(recordingID, linkType string) (string, error) {
-	recording, err := c.Get(recordingID)
+func (c *Client) getDownloadLink(ctx context.Context, recordingID, linkType string) (string, error) {
+	recording, err := c.GetCtx(ctx, recordingID)
 	if err != nil {
 		return "", err
 	}
@@ -279,32 +314,97 @@ func (c *Client) getDownloadLink(recordingID, linkType string) (string, error) {
 // DownloadRecording downloads the video recording (MP4) content.
 // This first fetches the temporary download link, then downloads the recording file.
 func (c *Client) DownloadRecording(recordingID string) (*DownloadedContent, error) {
-	link, err := c.getDownloadLink(recordingID, "recording")
+	return c.DownloadRecordingCtx(context.Background(), recordingID)
+}
+
+// DownloadRecordingCtx is like DownloadRecording but uses ctx for the request.
+func (c *Client) DownloadRecordingCtx(ctx context.Context, recordingID string) (*DownloadedContent, error) {
+	link, err := c.getDownloadLink(ctx, recordingID, "recording")
+	if err != nil {
+		return nil, err
+	}
+	return c.downloadFromURL(ctx, link)
+}
+
+// DownloadTo streams the video recording (MP4) to w instead of holding it in
+// memory, which multi-gigabyte recordings would not fit in. It returns the
+// recording's metadata with ContentLength set to the number of bytes written
+// and Data nil.
+//
+// Failed requests are retried according to the webexsdk.Client's Config. If
+// the connection drops mid-transfer, the download resumes from the last byte
+// written with an HTTP Range request. An error is returned if the number of
+// bytes written does not match the Content-Length.
+func (c *Client) DownloadTo(ctx context.Context, recordingID string, w io.Writer) (*DownloadedContent, error) {
+	link, err := c.getDownloadLink(ctx, recordingID, "recording")
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.webexClient.Download(ctx, link, w, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading content: %w", err)
+	}
+	return downloadedContent(result), nil
+}
+
+// DownloadToFile streams the video recording (MP4) to a file like
+// DownloadTo. If path is an existing directory, the file is saved in it
+// under the filename from the Content-Disposition header (or
+// "<recordingID>.mp4" if the server sends none), numbered if a file of
+// that name exists; the path written is returned in
+// DownloadedContent.Path. The file is removed if the download
+// fails.
+func (c *Client) DownloadToFile(ctx context.Context, recordingID, path string) (*DownloadedContent, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	link, err := c.getDownloadLink(ctx, recordingID, "recording")
 	if err != nil {
 		return nil, err
 	}
-	return c.downloadFromURL(link)
+	result, err := c.webexClient.DownloadToFile(ctx, link, path, recordingID+".mp4", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading content: %w", err)
+	}
+	return downloadedContent(result), nil
+}
+
+// downloadedContent converts the result of a streamed download to a
+// DownloadedContent.
+func downloadedContent(result *webexsdk.DownloadResult) *DownloadedContent {
+	return &DownloadedContent{
+		ContentType:        result.ContentType,
+		ContentDisposition: result.ContentDisposition,
+		ContentLength:      result.Size,
+		FileName:           result.FileName,
+		Path:               result.Path,
+	}
 }
 
 // DownloadTranscript downloads the transcript file for a recording.
 // This first fetches the temporary download link, then downloads the transcript.
 func (c *Client) DownloadTranscript(recordingID string) (*DownloadedContent, error) {
-	link, err := c.getDownloadLink(recordingID, "transcript")
+	return c.DownloadTranscriptCtx(context.Background(), recordingID)
+}
+
+// DownloadTranscriptCtx is like DownloadTranscript but uses ctx for the request.
+func (c *Client) DownloadTranscriptCtx(ctx context.Context, recordingID string) (*DownloadedContent, error) {
+	link, err := c.getDownloadLink(ctx, recordingID, "transcript")
 	if err != nil {
 		return nil, err
 	}
-	return c.downloadFromURL(link)
+	return c.downloadFromURL(ctx, link)
 }
 
 // downloadFromURL fetches content from a direct download URL.
-func (c *Client) downloadFromURL(downloadURL string) (*DownloadedContent, error) {
-	req, err := http.NewRequest(http.MethodGet, downloadURL, nil)
+func (c *Client) downloadFromURL(ctx context.Context, downloadURL string) (*DownloadedContent, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating download request: %w", err)
 	}
 	req.Header.Set("Authorization", "Bearer "+c.webexClient.GetAccessToken())
 
-	resp, err := c.webexClient.GetHTTPClient().Do(req)
+	resp, err := c.webexClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error downloading content: %w", err)
 	}
@@ -7,10 +7,14 @@
 package recordings
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -504,3 +508,66 @@ func TestDownloadTranscript_NoLinks(t *testing.T) {
 		t.Error("Expected error when no download links available")
 	}
 }
+
+func TestDownloadTo(t *testing.T) {
+	videoContent := []byte("fake mp4 video content")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/recordings/rec-123":
+			w.Header().Set("Content-Type", "application/json")
+			recording := Recording{
+				ID: "rec-123",
+				TemporaryDirectDownloadLinks: &TemporaryDownloadLinks{
+					RecordingDownloadLink: "http://" + r.Host + "/download/video.mp4",
+				},
+			}
+			_ = json.NewEncoder(w).Encode(recording)
+		case "/download/video.mp4":
+			if r.Header.Get("Authorization") != "Bearer test-token" {
+				t.Errorf("Expected auth header on download request")
+			}
+			w.Header().Set("Content-Type", "video/mp4")
+			w.Header().Set("Content-Disposition", `attachment; filename="Team Sync.mp4"`)
+			_, _ = w.Write(videoContent)
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	client, _ := webexsdk.NewClient("test-token", &webexsdk.Config{
+		BaseURL:        server.URL,
+		Timeout:        5 * time.Second,
+		HttpClient:     server.Client(),
+		DefaultHeaders: make(map[string]string),
+	})
+	client.BaseURL = baseURL
+	rc := New(client, nil)
+
+	var buf bytes.Buffer
+	content, err := rc.DownloadTo(context.Background(), "rec-123", &buf)
+	if err != nil {
+		t.Fatalf("DownloadTo failed: %v", err)
+	}
+	if buf.String() != string(videoContent) {
+		t.Errorf("Video data mismatch")
+	}
+	if content.FileName != "Team Sync.mp4" || content.ContentLength != int64(len(videoContent)) {
+		t.Errorf("Unexpected metadata: %+v", content)
+	}
+
+	dir := t.TempDir()
+	content, err = rc.DownloadToFile(context.Background(), "rec-123", dir)
+	if err != nil {
+		t.Fatalf("DownloadToFile failed: %v", err)
+	}
+	if content.Path != filepath.Join(dir, "Team Sync.mp4") {
+		t.Errorf("Unexpected path: %s", content.Path)
+	}
+	if data, _ := os.ReadFile(content.Path); string(data) != string(videoContent) {
+		t.Errorf("File content mismatch")
+	}
+}
@@ -0,0 +1,118 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package roles
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// Role represents an administrator role that can be assigned to people
+type Role struct {
+	ID     string                  `json:"id,omitempty"`
+	Name   string                  `json:"name,omitempty"`
+	Errors webexsdk.ResourceErrors `json:"errors,omitempty"`
+}
+
+// RolesPage represents a paginated list of roles
+type RolesPage = webexsdk.TypedPage[Role]
+
+// Config holds the configuration for the Roles plugin
+type Config struct {
+	// Any configuration settings for the roles plugin can go here
+}
+
+// DefaultConfig returns the default configuration for the Roles plugin
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Client is the roles API client
+type Client struct {
+	webexClient *webexsdk.Client
+	config      *Config
+}
+
+// New creates a new Roles plugin
+func New(webexClient *webexsdk.Client, config *Config) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	return &Client{
+		webexClient: webexClient,
+		config:      config,
+	}
+}
+
+// List returns the roles available in the organization
+func (c *Client) List() (*RolesPage, error) {
+	return c.ListCtx(context.Background())
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context) (*RolesPage, error) {
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "roles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := webexsdk.NewPage(resp, c.webexClient, webexsdk.ResourceRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	return webexsdk.NewTypedPage[Role](page)
+}
+
+// ListAll returns an Iterator over every Role, following pagination links
+// across pages. Use Limit on the returned Iterator to cap the number of
+// items.
+func (c *Client) ListAll(ctx context.Context) *webexsdk.Iterator[Role] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Role, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Items, page.Page, nil
+	})
+}
+
+// All returns a range-over-func sequence of every Role.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context) iter.Seq2[Role, error] {
+	return c.ListAll(ctx).All()
+}
+
+// Get returns details for a role
+func (c *Client) Get(roleID string) (*Role, error) {
+	return c.GetCtx(context.Background(), roleID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, roleID string) (*Role, error) {
+	if roleID == "" {
+		return nil, fmt.Errorf("roleID is required")
+	}
+
+	path := fmt.Sprintf("roles/%s", roleID)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role Role
+	if err := webexsdk.ParseResponse(resp, &role); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
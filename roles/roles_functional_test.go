@@ -0,0 +1,62 @@
+//go:build functional
+
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package roles
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func functionalClient(t *testing.T) *webexsdk.Client {
+	t.Helper()
+	token := os.Getenv("WEBEX_ACCESS_TOKEN")
+	if token == "" {
+		t.Fatal("WEBEX_ACCESS_TOKEN environment variable is required")
+	}
+	client, err := webexsdk.NewClient(token, &webexsdk.Config{
+		BaseURL: "https://webexapis.com/v1",
+		Timeout: 30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create Webex client: %v", err)
+	}
+	return client
+}
+
+// TestFunctionalRolesListAndGet lists roles and fetches the first one.
+// It requires an admin token.
+// Run with:
+//
+//	WEBEX_ACCESS_TOKEN=<admin-token> go test -tags functional -run TestFunctionalRolesListAndGet -v ./roles/
+func TestFunctionalRolesListAndGet(t *testing.T) {
+	plugin := New(functionalClient(t), nil)
+
+	page, err := plugin.List()
+	if webexsdk.IsForbidden(err) {
+		t.Skipf("Token lacks admin scopes: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	t.Logf("Found %d roles", len(page.Items))
+	if len(page.Items) == 0 {
+		t.Skip("No roles to get")
+	}
+
+	role, err := plugin.Get(page.Items[0].ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if role.ID != page.Items[0].ID {
+		t.Errorf("Get ID mismatch: got %s, want %s", role.ID, page.Items[0].ID)
+	}
+}
@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package roles
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	baseURL, _ := url.Parse(server.URL)
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.BaseURL = baseURL
+
+	return New(client, nil), server
+}
+
+func TestList(t *testing.T) {
+	rolesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/roles" {
+			t.Errorf("Expected path '/roles', got '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []Role{{ID: "role-1", Name: "Full Administrator"}, {ID: "role-2", Name: "User and Device Administrator"}},
+		})
+	})
+	defer server.Close()
+
+	page, err := rolesPlugin.List()
+	if err != nil {
+		t.Fatalf("Failed to list roles: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Name != "Full Administrator" {
+		t.Errorf("Unexpected roles: %+v", page.Items)
+	}
+}
+
+func TestGet(t *testing.T) {
+	rolesPlugin, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/roles/role-1" {
+			t.Errorf("Expected path '/roles/role-1', got '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Role{ID: "role-1", Name: "Full Administrator"})
+	})
+	defer server.Close()
+
+	role, err := rolesPlugin.Get("role-1")
+	if err != nil {
+		t.Fatalf("Failed to get role: %v", err)
+	}
+	if role.Name != "Full Administrator" {
+		t.Errorf("Expected name 'Full Administrator', got '%s'", role.Name)
+	}
+
+	if _, err := rolesPlugin.Get(""); err == nil {
+		t.Error("Expected error for empty roleID")
+	}
+}
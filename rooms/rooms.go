@@ -7,8 +7,9 @@
 package rooms
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -49,10 +50,7 @@ type ListOptions struct {
 }
 
 // RoomsPage represents a paginated list of rooms
-type RoomsPage struct {
-	Items []Room `json:"items"`
-	*webexsdk.Page
-}
+type RoomsPage = webexsdk.TypedPage[Room]
 
 // Config holds the configuration for the Rooms plugin
 type Config struct {
@@ -84,11 +82,16 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // Create creates a new room
 func (c *Client) Create(room *Room) (*Room, error) {
+	return c.CreateCtx(context.Background(), room)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, room *Room) (*Room, error) {
 	if room.Title == "" {
 		return nil, fmt.Errorf("title is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "rooms", nil, room)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "rooms", nil, room)
 	if err != nil {
 		return nil, err
 	}
@@ -103,12 +106,17 @@ func (c *Client) Create(room *Room) (*Room, error) {
 
 // Get returns a single room by ID
 func (c *Client) Get(roomID string) (*Room, error) {
+	return c.GetCtx(context.Background(), roomID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, roomID string) (*Room, error) {
 	if roomID == "" {
 		return nil, fmt.Errorf("roomID is required")
 	}
 
 	path := fmt.Sprintf("rooms/%s", roomID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +131,11 @@ func (c *Client) Get(roomID string) (*Room, error) {
 
 // List returns a list of rooms
 func (c *Client) List(options *ListOptions) (*RoomsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*RoomsPage, error) {
 	if options == nil {
 		options = &ListOptions{}
 	}
@@ -146,7 +159,7 @@ func (c *Client) List(options *ListOptions) (*RoomsPage, error) {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "rooms", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "rooms", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,31 +169,41 @@ func (c *Client) List(options *ListOptions) (*RoomsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Rooms
-	roomsPage := &RoomsPage{
-		Page:  page,
-		Items: make([]Room, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Room](page)
+}
 
-	for i, item := range page.Items {
-		var room Room
-		if err := json.Unmarshal(item, &room); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Room matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Room] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Room, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		roomsPage.Items[i] = room
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return roomsPage, nil
+// All returns a range-over-func sequence of every Room matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Room, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Update updates an existing room
 func (c *Client) Update(roomID string, room *Room) (*Room, error) {
+	return c.UpdateCtx(context.Background(), roomID, room)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, roomID string, room *Room) (*Room, error) {
 	if roomID == "" {
 		return nil, fmt.Errorf("roomID is required")
 	}
 
 	path := fmt.Sprintf("rooms/%s", roomID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, room)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, room)
 	if err != nil {
 		return nil, err
 	}
@@ -195,12 +218,17 @@ func (c *Client) Update(roomID string, room *Room) (*Room, error) {
 
 // Delete removes a room
 func (c *Client) Delete(roomID string) error {
+	return c.DeleteCtx(context.Background(), roomID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, roomID string) error {
 	if roomID == "" {
 		return fmt.Errorf("roomID is required")
 	}
 
 	path := fmt.Sprintf("rooms/%s", roomID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
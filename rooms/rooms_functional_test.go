@@ -9,6 +9,7 @@
 package rooms
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -166,26 +167,26 @@ func TestFunctionalRoomsListPagination(t *testing.T) {
 
 	totalItems := len(page.Items)
 	pageCount := 1
-	t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(page.Items), page.HasNext)
+	t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(page.Items), page.HasNext())
 
 	// Save cursor for direct navigation test
 	var page2Cursor string
-	if page.HasNext {
+	if page.HasNext() {
 		page2Cursor = page.NextPage
 		t.Logf("Saved page 2 cursor: %s", page2Cursor)
 	}
 
 	// Traverse pages (limit to 10 to avoid runaway)
-	for page.HasNext && pageCount < 10 {
-		nextPage, err := page.Next()
+	for page.HasNext() && pageCount < 10 {
+		nextPage, err := page.Next(context.Background())
 		if err != nil {
 			t.Fatalf("Next() failed on page %d: %v", pageCount, err)
 		}
 
-		page.Page = nextPage
+		page = nextPage
 		pageCount++
 		totalItems += len(nextPage.Items)
-		t.Logf("Page %d: %d raw items, hasNext=%v", pageCount, len(nextPage.Items), nextPage.HasNext)
+		t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(nextPage.Items), nextPage.HasNext())
 	}
 
 	t.Logf("Pagination complete: %d total items across %d pages", totalItems, pageCount)
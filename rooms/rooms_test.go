@@ -7,7 +7,9 @@
 package rooms
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -518,3 +520,40 @@ func TestList_PartialFailures(t *testing.T) {
 		t.Errorf("Expected id 'room-2', got %q", page.Items[1].ID)
 	}
 }
+
+func TestGetCtx_DeadlineStopsRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+		MaxRetries: 3,
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	roomsPlugin := New(client, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = roomsPlugin.GetCtx(ctx, "test-room-id")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the retry wait to be cut short, took %v", elapsed)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request before the deadline, got %d", requests)
+	}
+}
@@ -7,8 +7,9 @@
 package roomtabs
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -34,10 +35,7 @@ type ListOptions struct {
 }
 
 // RoomTabsPage represents a paginated list of room tabs
-type RoomTabsPage struct {
-	Items []RoomTab `json:"items"`
-	*webexsdk.Page
-}
+type RoomTabsPage = webexsdk.TypedPage[RoomTab]
 
 // Config holds the configuration for the RoomTabs plugin
 type Config struct {
@@ -69,6 +67,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // List returns a list of room tabs for a specified room
 func (c *Client) List(options *ListOptions) (*RoomTabsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*RoomTabsPage, error) {
 	if options == nil || options.RoomID == "" {
 		return nil, fmt.Errorf("roomId is required")
 	}
@@ -77,7 +80,7 @@ func (c *Client) List(options *ListOptions) (*RoomTabsPage, error) {
 	params := url.Values{}
 	params.Set("roomId", options.RoomID)
 
-	resp, err := c.webexClient.Request(http.MethodGet, "room/tabs", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "room/tabs", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -87,25 +90,35 @@ func (c *Client) List(options *ListOptions) (*RoomTabsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into RoomTabs
-	roomTabsPage := &RoomTabsPage{
-		Page:  page,
-		Items: make([]RoomTab, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[RoomTab](page)
+}
 
-	for i, item := range page.Items {
-		var roomTab RoomTab
-		if err := json.Unmarshal(item, &roomTab); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every RoomTab matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[RoomTab] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]RoomTab, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		roomTabsPage.Items[i] = roomTab
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return roomTabsPage, nil
+// All returns a range-over-func sequence of every RoomTab matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[RoomTab, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Create creates a new room tab
 func (c *Client) Create(tab *RoomTab) (*RoomTab, error) {
+	return c.CreateCtx(context.Background(), tab)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, tab *RoomTab) (*RoomTab, error) {
 	if tab.RoomID == "" {
 		return nil, fmt.Errorf("roomId is required")
 	}
@@ -116,7 +129,7 @@ func (c *Client) Create(tab *RoomTab) (*RoomTab, error) {
 		return nil, fmt.Errorf("displayName is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "room/tabs", nil, tab)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "room/tabs", nil, tab)
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +144,17 @@ func (c *Client) Create(tab *RoomTab) (*RoomTab, error) {
 
 // Get returns details for a room tab
 func (c *Client) Get(tabID string) (*RoomTab, error) {
+	return c.GetCtx(context.Background(), tabID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, tabID string) (*RoomTab, error) {
 	if tabID == "" {
 		return nil, fmt.Errorf("tabID is required")
 	}
 
 	path := fmt.Sprintf("room/tabs/%s", tabID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +169,11 @@ func (c *Client) Get(tabID string) (*RoomTab, error) {
 
 // Update updates an existing room tab
 func (c *Client) Update(tabID string, tab *RoomTab) (*RoomTab, error) {
+	return c.UpdateCtx(context.Background(), tabID, tab)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, tabID string, tab *RoomTab) (*RoomTab, error) {
 	if tabID == "" {
 		return nil, fmt.Errorf("tabID is required")
 	}
@@ -165,7 +188,7 @@ func (c *Client) Update(tabID string, tab *RoomTab) (*RoomTab, error) {
 	}
 
 	path := fmt.Sprintf("room/tabs/%s", tabID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, tab)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, tab)
 	if err != nil {
 		return nil, err
 	}
@@ -180,12 +203,17 @@ func (c *Client) Update(tabID string, tab *RoomTab) (*RoomTab, error) {
 
 // Delete deletes a room tab
 func (c *Client) Delete(tabID string) error {
+	return c.DeleteCtx(context.Background(), tabID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, tabID string) error {
 	if tabID == "" {
 		return fmt.Errorf("tabID is required")
 	}
 
 	path := fmt.Sprintf("room/tabs/%s", tabID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
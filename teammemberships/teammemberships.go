@@ -7,8 +7,9 @@
 package teammemberships
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -35,10 +36,10 @@ type ListOptions struct {
 }
 
 // TeamMembershipsPage represents a paginated list of team memberships
-type TeamMembershipsPage struct {
-	Items []TeamMembership `json:"items"`
-	*webexsdk.Page
-}
+type TeamMembershipsPage = webexsdk.TypedPage[TeamMembership]
+
+// AddReport reports the outcome of AddMany per email, in input order
+type AddReport = webexsdk.BulkReport[string, *TeamMembership]
 
 // Config holds the configuration for the TeamMemberships plugin
 type Config struct {
@@ -70,6 +71,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // List returns a list of team memberships for a specified team
 func (c *Client) List(options *ListOptions) (*TeamMembershipsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*TeamMembershipsPage, error) {
 	if options == nil || options.TeamID == "" {
 		return nil, fmt.Errorf("teamId is required")
 	}
@@ -81,7 +87,7 @@ func (c *Client) List(options *ListOptions) (*TeamMembershipsPage, error) {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "team/memberships", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "team/memberships", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -91,25 +97,35 @@ func (c *Client) List(options *ListOptions) (*TeamMembershipsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into TeamMemberships
-	teamMembershipsPage := &TeamMembershipsPage{
-		Page:  page,
-		Items: make([]TeamMembership, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[TeamMembership](page)
+}
 
-	for i, item := range page.Items {
-		var teamMembership TeamMembership
-		if err := json.Unmarshal(item, &teamMembership); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every TeamMembership matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[TeamMembership] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]TeamMembership, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		teamMembershipsPage.Items[i] = teamMembership
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return teamMembershipsPage, nil
+// All returns a range-over-func sequence of every TeamMembership matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[TeamMembership, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Create creates a new team membership
 func (c *Client) Create(membership *TeamMembership) (*TeamMembership, error) {
+	return c.CreateCtx(context.Background(), membership)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, membership *TeamMembership) (*TeamMembership, error) {
 	if membership.TeamID == "" {
 		return nil, fmt.Errorf("teamId is required")
 	}
@@ -117,7 +133,7 @@ func (c *Client) Create(membership *TeamMembership) (*TeamMembership, error) {
 		return nil, fmt.Errorf("either personId or personEmail is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "team/memberships", nil, membership)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "team/memberships", nil, membership)
 	if err != nil {
 		return nil, err
 	}
@@ -130,14 +146,50 @@ func (c *Client) Create(membership *TeamMembership) (*TeamMembership, error) {
 	return &result, nil
 }
 
+// AddMany adds people to a team by email, several at a time. Some may
+// fail while others succeed; the report holds the outcome for every email.
+// People who are already members are reported as skipped.
+func (c *Client) AddMany(teamID string, emails []string) *AddReport {
+	return c.AddManyCtx(context.Background(), teamID, emails, nil)
+}
+
+// AddManyCtx is like AddMany but uses ctx for the requests and config for
+// the concurrency and retries; config may be nil. Once ctx is done, the
+// remaining emails are skipped.
+//
+// Unless config sets MaxAttempts, each email is tried once: a POST that
+// failed with a 5xx or a dropped connection may still have added the
+// person, and a second attempt would then report them as skipped with a
+// 409. The client still retries 429 responses, which are never applied.
+func (c *Client) AddManyCtx(ctx context.Context, teamID string, emails []string, config *webexsdk.BulkConfig) *AddReport {
+	bulkConfig := webexsdk.BulkConfig{}
+	if config != nil {
+		bulkConfig = *config
+	}
+	if bulkConfig.MaxAttempts <= 0 {
+		bulkConfig.MaxAttempts = 1
+	}
+	if bulkConfig.Skip == nil {
+		bulkConfig.Skip = webexsdk.IsConflict
+	}
+	return webexsdk.RunBulk(ctx, emails, func(ctx context.Context, email string) (*TeamMembership, error) {
+		return c.CreateCtx(ctx, &TeamMembership{TeamID: teamID, PersonEmail: email})
+	}, &bulkConfig)
+}
+
 // Get returns details for a team membership
 func (c *Client) Get(membershipID string) (*TeamMembership, error) {
+	return c.GetCtx(context.Background(), membershipID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, membershipID string) (*TeamMembership, error) {
 	if membershipID == "" {
 		return nil, fmt.Errorf("membershipID is required")
 	}
 
 	path := fmt.Sprintf("team/memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +204,11 @@ func (c *Client) Get(membershipID string) (*TeamMembership, error) {
 
 // Update updates an existing team membership
 func (c *Client) Update(membershipID string, isModerator bool) (*TeamMembership, error) {
+	return c.UpdateCtx(context.Background(), membershipID, isModerator)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, membershipID string, isModerator bool) (*TeamMembership, error) {
 	if membershipID == "" {
 		return nil, fmt.Errorf("membershipID is required")
 	}
@@ -162,7 +219,7 @@ func (c *Client) Update(membershipID string, isModerator bool) (*TeamMembership,
 	}
 
 	path := fmt.Sprintf("team/memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, updatedMembership)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, updatedMembership)
 	if err != nil {
 		return nil, err
 	}
@@ -177,12 +234,17 @@ func (c *Client) Update(membershipID string, isModerator bool) (*TeamMembership,
 
 // Delete deletes a team membership
 func (c *Client) Delete(membershipID string) error {
+	return c.DeleteCtx(context.Background(), membershipID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, membershipID string) error {
 	if membershipID == "" {
 		return fmt.Errorf("membershipID is required")
 	}
 
 	path := fmt.Sprintf("team/memberships/%s", membershipID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
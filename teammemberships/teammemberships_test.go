@@ -7,10 +7,12 @@
 package teammemberships
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -426,3 +428,101 @@ func TestDelete(t *testing.T) {
 		t.Fatalf("Failed to delete team membership: %v", err)
 	}
 }
+
+func TestAddMany(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team/memberships" {
+			t.Errorf("Expected path '/team/memberships', got '%s'", r.URL.Path)
+		}
+		var membership TeamMembership
+		_ = json.NewDecoder(r.Body).Decode(&membership)
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		if membership.PersonEmail == "member@example.com" {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"User is already a member of the team"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(TeamMembership{ID: "membership-1", TeamID: membership.TeamID, PersonEmail: membership.PersonEmail})
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	config := &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	}
+	client, err := webexsdk.NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.BaseURL = baseURL
+	teamMembershipsPlugin := New(client, nil)
+
+	emails := []string{"alice@example.com", "member@example.com"}
+	report := teamMembershipsPlugin.AddManyCtx(context.Background(), "test-team-id", emails, &webexsdk.BulkConfig{Concurrency: 1})
+	if report.Err() != nil {
+		t.Errorf("Expected no failures, got %v", report.Err())
+	}
+	if report.Results[0].Status != webexsdk.BulkSucceeded || report.Results[0].Value.TeamID != "test-team-id" {
+		t.Errorf("Expected alice to be added, got %+v", report.Results[0])
+	}
+	if report.Results[1].Status != webexsdk.BulkSkipped || !webexsdk.IsConflict(report.Results[1].Err) {
+		t.Errorf("Expected the existing member to be skipped, got %+v", report.Results[1])
+	}
+
+	// Without a team ID every email fails before a request is sent
+	requests = 0
+	report = teamMembershipsPlugin.AddMany("", emails)
+	if len(report.Failed()) != 2 || requests != 0 {
+		t.Errorf("Expected 2 failures and no requests, got %d failures and %d requests", len(report.Failed()), requests)
+	}
+}
+
+func TestAddManyDoesNotRetryByDefault(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The first request adds the person but fails; a retry would see them as a member
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"Internal error"}`))
+			return
+		}
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"Already a member"}`))
+	}))
+	defer server.Close()
+
+	client, err := webexsdk.NewClient("test-token", &webexsdk.Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		HttpClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	plugin := New(client, nil)
+
+	report := plugin.AddMany("test-team-id", []string{"alice@example.com"})
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+	if failed := report.Failed(); len(failed) != 1 || !webexsdk.IsServerError(failed[0].Err) {
+		t.Errorf("Expected the 500 to be reported as a failure, got %+v", report.Results)
+	}
+
+	// Retries are opt-in
+	requests.Store(0)
+	report = plugin.AddManyCtx(context.Background(), "test-team-id", []string{"alice@example.com"},
+		&webexsdk.BulkConfig{MaxAttempts: 2, RetryDelay: time.Millisecond})
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests with MaxAttempts 2, got %d", requests.Load())
+	}
+	if skipped := report.Skipped(); len(skipped) != 1 {
+		t.Errorf("Expected the retried email to be skipped, got %+v", report.Results)
+	}
+}
@@ -7,8 +7,9 @@
 package teams
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -32,10 +33,7 @@ type ListOptions struct {
 }
 
 // TeamsPage represents a paginated list of teams
-type TeamsPage struct {
-	Items []Team `json:"items"`
-	*webexsdk.Page
-}
+type TeamsPage = webexsdk.TypedPage[Team]
 
 // Config holds the configuration for the Teams plugin
 type Config struct {
@@ -67,12 +65,17 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // List returns a list of teams
 func (c *Client) List(options *ListOptions) (*TeamsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*TeamsPage, error) {
 	params := url.Values{}
 	if options != nil && options.Max > 0 {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "teams", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "teams", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,30 +85,40 @@ func (c *Client) List(options *ListOptions) (*TeamsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Teams
-	teamsPage := &TeamsPage{
-		Page:  page,
-		Items: make([]Team, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Team](page)
+}
 
-	for i, item := range page.Items {
-		var team Team
-		if err := json.Unmarshal(item, &team); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Team matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Team] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Team, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		teamsPage.Items[i] = team
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return teamsPage, nil
+// All returns a range-over-func sequence of every Team matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Team, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Create creates a new team
 func (c *Client) Create(team *Team) (*Team, error) {
+	return c.CreateCtx(context.Background(), team)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, team *Team) (*Team, error) {
 	if team.Name == "" {
 		return nil, fmt.Errorf("team name is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "teams", nil, team)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "teams", nil, team)
 	if err != nil {
 		return nil, err
 	}
@@ -120,12 +133,17 @@ func (c *Client) Create(team *Team) (*Team, error) {
 
 // Get returns details for a team
 func (c *Client) Get(teamID string) (*Team, error) {
+	return c.GetCtx(context.Background(), teamID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, teamID string) (*Team, error) {
 	if teamID == "" {
 		return nil, fmt.Errorf("teamID is required")
 	}
 
 	path := fmt.Sprintf("teams/%s", teamID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +158,11 @@ func (c *Client) Get(teamID string) (*Team, error) {
 
 // Update updates an existing team
 func (c *Client) Update(teamID string, team *Team) (*Team, error) {
+	return c.UpdateCtx(context.Background(), teamID, team)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, teamID string, team *Team) (*Team, error) {
 	if teamID == "" {
 		return nil, fmt.Errorf("teamID is required")
 	}
@@ -148,7 +171,7 @@ func (c *Client) Update(teamID string, team *Team) (*Team, error) {
 	}
 
 	path := fmt.Sprintf("teams/%s", teamID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, team)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, team)
 	if err != nil {
 		return nil, err
 	}
@@ -163,12 +186,17 @@ func (c *Client) Update(teamID string, team *Team) (*Team, error) {
 
 // Delete deletes a team
 func (c *Client) Delete(teamID string) error {
+	return c.DeleteCtx(context.Background(), teamID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, teamID string) error {
 	if teamID == "" {
 		return fmt.Errorf("teamID is required")
 	}
 
 	path := fmt.Sprintf("teams/%s", teamID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@
 package teams
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -138,24 +139,24 @@ func TestFunctionalTeamsListPagination(t *testing.T) {
 
 	totalItems := len(page.Items)
 	pageCount := 1
-	t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(page.Items), page.HasNext)
+	t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(page.Items), page.HasNext())
 
 	// Save cursor for direct navigation test
 	var page2Cursor string
-	if page.HasNext {
+	if page.HasNext() {
 		page2Cursor = page.NextPage
 		t.Logf("Saved page 2 cursor: %s", page2Cursor)
 	}
 
-	for page.HasNext && pageCount < 10 {
-		nextPage, err := page.Next()
+	for page.HasNext() && pageCount < 10 {
+		nextPage, err := page.Next(context.Background())
 		if err != nil {
 			t.Fatalf("Next() failed: %v", err)
 		}
-		page.Page = nextPage
+		page = nextPage
 		pageCount++
 		totalItems += len(nextPage.Items)
-		t.Logf("Page %d: %d raw items, hasNext=%v", pageCount, len(nextPage.Items), nextPage.HasNext)
+		t.Logf("Page %d: %d items, hasNext=%v", pageCount, len(nextPage.Items), nextPage.HasNext())
 	}
 
 	t.Logf("Pagination complete: %d total items across %d pages", totalItems, pageCount)
@@ -7,9 +7,10 @@
 package transcripts
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -76,16 +77,10 @@ type SnippetListOptions struct {
 }
 
 // TranscriptsPage represents a paginated list of transcripts
-type TranscriptsPage struct {
-	Items []Transcript `json:"items"`
-	*webexsdk.Page
-}
+type TranscriptsPage = webexsdk.TypedPage[Transcript]
 
 // SnippetsPage represents a paginated list of transcript snippets
-type SnippetsPage struct {
-	Items []Snippet `json:"items"`
-	*webexsdk.Page
-}
+type SnippetsPage = webexsdk.TypedPage[Snippet]
 
 // Config holds the configuration for the Transcripts plugin
 type Config struct {
@@ -120,6 +115,11 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 // If 'from' and 'to' are not specified and no meetingId is provided, the SDK defaults
 // to the last 30 days to ensure results are returned.
 func (c *Client) List(options *ListOptions) (*TranscriptsPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*TranscriptsPage, error) {
 	params := url.Values{}
 
 	if options == nil {
@@ -154,7 +154,7 @@ func (c *Client) List(options *ListOptions) (*TranscriptsPage, error) {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "meetingTranscripts", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "meetingTranscripts", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -164,21 +164,26 @@ func (c *Client) List(options *ListOptions) (*TranscriptsPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Transcripts
-	transcriptsPage := &TranscriptsPage{
-		Page:  page,
-		Items: make([]Transcript, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Transcript](page)
+}
 
-	for i, item := range page.Items {
-		var transcript Transcript
-		if err := json.Unmarshal(item, &transcript); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Transcript matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Transcript] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Transcript, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		transcriptsPage.Items[i] = transcript
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return transcriptsPage, nil
+// All returns a range-over-func sequence of every Transcript matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Transcript, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // DownloadOptions contains optional parameters for downloading a transcript
@@ -194,6 +199,11 @@ type DownloadOptions struct {
 // An optional DownloadOptions can be provided to include the meetingId parameter
 // as returned by the Webex API in vttDownloadLink/txtDownloadLink.
 func (c *Client) Download(transcriptID string, format string, opts ...*DownloadOptions) (string, error) {
+	return c.DownloadCtx(context.Background(), transcriptID, format, opts...)
+}
+
+// DownloadCtx is like Download but uses ctx for the request.
+func (c *Client) DownloadCtx(ctx context.Context, transcriptID string, format string, opts ...*DownloadOptions) (string, error) {
 	if transcriptID == "" {
 		return "", fmt.Errorf("transcriptID is required")
 	}
@@ -214,7 +224,7 @@ func (c *Client) Download(transcriptID string, format string, opts ...*DownloadO
 	}
 
 	path := fmt.Sprintf("meetingTranscripts/%s/download", transcriptID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, params, nil)
 	if err != nil {
 		return "", err
 	}
@@ -235,6 +245,11 @@ func (c *Client) Download(transcriptID string, format string, opts ...*DownloadO
 
 // ListSnippets returns a list of snippets for a transcript
 func (c *Client) ListSnippets(transcriptID string, options *SnippetListOptions) (*SnippetsPage, error) {
+	return c.ListSnippetsCtx(context.Background(), transcriptID, options)
+}
+
+// ListSnippetsCtx is like ListSnippets but uses ctx for the request.
+func (c *Client) ListSnippetsCtx(ctx context.Context, transcriptID string, options *SnippetListOptions) (*SnippetsPage, error) {
 	if transcriptID == "" {
 		return nil, fmt.Errorf("transcriptID is required")
 	}
@@ -259,7 +274,7 @@ func (c *Client) ListSnippets(transcriptID string, options *SnippetListOptions)
 	}
 
 	path := fmt.Sprintf("meetingTranscripts/%s/snippets", transcriptID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -269,25 +284,35 @@ func (c *Client) ListSnippets(transcriptID string, options *SnippetListOptions)
 		return nil, err
 	}
 
-	// Unmarshal items into Snippets
-	snippetsPage := &SnippetsPage{
-		Page:  page,
-		Items: make([]Snippet, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Snippet](page)
+}
 
-	for i, item := range page.Items {
-		var snippet Snippet
-		if err := json.Unmarshal(item, &snippet); err != nil {
-			return nil, err
+// ListSnippetsAll returns an Iterator over every Snippet matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListSnippetsAll(ctx context.Context, transcriptID string, options *SnippetListOptions) *webexsdk.Iterator[Snippet] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Snippet, *webexsdk.Page, error) {
+		page, err := c.ListSnippetsCtx(ctx, transcriptID, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		snippetsPage.Items[i] = snippet
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return snippetsPage, nil
+// AllSnippets returns a range-over-func sequence of every Snippet matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) AllSnippets(ctx context.Context, transcriptID string, options *SnippetListOptions) iter.Seq2[Snippet, error] {
+	return c.ListSnippetsAll(ctx, transcriptID, options).All()
 }
 
 // GetSnippet returns a single transcript snippet
 func (c *Client) GetSnippet(transcriptID, snippetID string) (*Snippet, error) {
+	return c.GetSnippetCtx(context.Background(), transcriptID, snippetID)
+}
+
+// GetSnippetCtx is like GetSnippet but uses ctx for the request.
+func (c *Client) GetSnippetCtx(ctx context.Context, transcriptID, snippetID string) (*Snippet, error) {
 	if transcriptID == "" {
 		return nil, fmt.Errorf("transcriptID is required")
 	}
@@ -296,7 +321,7 @@ func (c *Client) GetSnippet(transcriptID, snippetID string) (*Snippet, error) {
 	}
 
 	path := fmt.Sprintf("meetingTranscripts/%s/snippets/%s", transcriptID, snippetID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +336,11 @@ func (c *Client) GetSnippet(transcriptID, snippetID string) (*Snippet, error) {
 
 // UpdateSnippet updates a transcript snippet's text
 func (c *Client) UpdateSnippet(transcriptID, snippetID string, snippet *Snippet) (*Snippet, error) {
+	return c.UpdateSnippetCtx(context.Background(), transcriptID, snippetID, snippet)
+}
+
+// UpdateSnippetCtx is like UpdateSnippet but uses ctx for the request.
+func (c *Client) UpdateSnippetCtx(ctx context.Context, transcriptID, snippetID string, snippet *Snippet) (*Snippet, error) {
 	if transcriptID == "" {
 		return nil, fmt.Errorf("transcriptID is required")
 	}
@@ -329,7 +359,7 @@ func (c *Client) UpdateSnippet(transcriptID, snippetID string, snippet *Snippet)
 	}
 
 	path := fmt.Sprintf("meetingTranscripts/%s/snippets/%s", transcriptID, snippetID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, updateData)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, updateData)
 	if err != nil {
 		return nil, err
 	}
@@ -10,18 +10,22 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/WebexCommunity/webex-go-sdk/v2/adminaudit"
 	"github.com/WebexCommunity/webex-go-sdk/v2/attachmentactions"
 	"github.com/WebexCommunity/webex-go-sdk/v2/calling"
 	"github.com/WebexCommunity/webex-go-sdk/v2/contents"
 	"github.com/WebexCommunity/webex-go-sdk/v2/conversation"
 	"github.com/WebexCommunity/webex-go-sdk/v2/device"
 	"github.com/WebexCommunity/webex-go-sdk/v2/events"
+	"github.com/WebexCommunity/webex-go-sdk/v2/licenses"
 	"github.com/WebexCommunity/webex-go-sdk/v2/meetings"
 	"github.com/WebexCommunity/webex-go-sdk/v2/memberships"
 	"github.com/WebexCommunity/webex-go-sdk/v2/mercury"
 	"github.com/WebexCommunity/webex-go-sdk/v2/messages"
+	"github.com/WebexCommunity/webex-go-sdk/v2/organizations"
 	"github.com/WebexCommunity/webex-go-sdk/v2/people"
 	"github.com/WebexCommunity/webex-go-sdk/v2/recordings"
+	"github.com/WebexCommunity/webex-go-sdk/v2/roles"
 	"github.com/WebexCommunity/webex-go-sdk/v2/rooms"
 	"github.com/WebexCommunity/webex-go-sdk/v2/roomtabs"
 	"github.com/WebexCommunity/webex-go-sdk/v2/teammemberships"
@@ -53,6 +57,10 @@ type WebexClient struct {
 	conversationClient      *conversation.Client
 	callingClient           *calling.Client
 	contentsClient          *contents.Client
+	organizationsClient     *organizations.Client
+	licensesClient          *licenses.Client
+	rolesClient             *roles.Client
+	adminAuditClient        *adminaudit.Client
 
 	// Internal plugins
 	mercuryClient *mercury.Client
@@ -60,6 +68,9 @@ type WebexClient struct {
 
 	// Mutex for thread-safe lazy initialization of conversation client
 	convMu sync.Mutex
+
+	// Mutex guarding mercuryClient, which Close and Pool read concurrently
+	mercuryMu sync.Mutex
 }
 
 // NewClient creates a new Webex client with the given access token and optional configuration
@@ -76,6 +87,24 @@ func NewClient(accessToken string, config *webexsdk.Config) (*WebexClient, error
 	return client, nil
 }
 
+// NewClientWithTokenSource creates a new Webex client that obtains access tokens
+// from tokenSource. Use it with webexsdk.OAuthConfig.TokenSource for Webex
+// Integrations so long-running services keep working after the access token
+// expires. Every subsystem (REST plugins, Mercury, encryption and calling)
+// reads the token through the same source.
+func NewClientWithTokenSource(tokenSource webexsdk.TokenSource, config *webexsdk.Config) (*WebexClient, error) {
+	core, err := webexsdk.NewClientWithTokenSource(tokenSource, config)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &WebexClient{
+		core: core,
+	}
+
+	return client, nil
+}
+
 // People returns the People plugin
 func (c *WebexClient) People() *people.Client {
 	if c.peopleClient == nil {
@@ -188,6 +217,38 @@ func (c *WebexClient) Transcripts() *transcripts.Client {
 	return c.transcriptsClient
 }
 
+// Organizations returns the Organizations plugin
+func (c *WebexClient) Organizations() *organizations.Client {
+	if c.organizationsClient == nil {
+		c.organizationsClient = organizations.New(c.core, nil)
+	}
+	return c.organizationsClient
+}
+
+// Licenses returns the Licenses plugin
+func (c *WebexClient) Licenses() *licenses.Client {
+	if c.licensesClient == nil {
+		c.licensesClient = licenses.New(c.core, nil)
+	}
+	return c.licensesClient
+}
+
+// Roles returns the Roles plugin
+func (c *WebexClient) Roles() *roles.Client {
+	if c.rolesClient == nil {
+		c.rolesClient = roles.New(c.core, nil)
+	}
+	return c.rolesClient
+}
+
+// AdminAudit returns the AdminAudit plugin
+func (c *WebexClient) AdminAudit() *adminaudit.Client {
+	if c.adminAuditClient == nil {
+		c.adminAuditClient = adminaudit.New(c.core, nil)
+	}
+	return c.adminAuditClient
+}
+
 // Calling returns the Calling plugin for Webex Calling APIs
 // (Call History, Call Settings, Voicemail, Contacts).
 func (c *WebexClient) Calling() *calling.Client {
@@ -258,6 +319,8 @@ func (c *WebexClient) Internal() *InternalPlugins {
 
 // Mercury returns the Mercury plugin (internal)
 func (c *WebexClient) Mercury() *mercury.Client {
+	c.mercuryMu.Lock()
+	defer c.mercuryMu.Unlock()
 	if c.mercuryClient == nil {
 		c.mercuryClient = mercury.New(c.core, nil)
 		// Set the Device plugin as the DeviceProvider for Mercury
@@ -284,3 +347,21 @@ type InternalPlugins struct {
 func (c *WebexClient) Core() *webexsdk.Client {
 	return c.core
 }
+
+// Close disconnects the Mercury WebSocket, and with it the Conversation
+// client, if one was opened. REST plugins hold no connections of their own
+// and keep working after Close.
+func (c *WebexClient) Close() error {
+	mc := c.openedMercury()
+	if mc == nil {
+		return nil
+	}
+	return mc.Disconnect()
+}
+
+// openedMercury returns the Mercury plugin, or nil if it was never created.
+func (c *WebexClient) openedMercury() *mercury.Client {
+	c.mercuryMu.Lock()
+	defer c.mercuryMu.Unlock()
+	return c.mercuryClient
+}
@@ -144,6 +144,18 @@ func TestWebexClientAccessors(t *testing.T) {
 	if client.Transcripts() == nil {
 		t.Error("Transcripts() should not return nil")
 	}
+	if client.Organizations() == nil {
+		t.Error("Organizations() should not return nil")
+	}
+	if client.Licenses() == nil {
+		t.Error("Licenses() should not return nil")
+	}
+	if client.Roles() == nil {
+		t.Error("Roles() should not return nil")
+	}
+	if client.AdminAudit() == nil {
+		t.Error("AdminAudit() should not return nil")
+	}
 
 	// Verify Internal() returns populated struct
 	internal := client.Internal()
@@ -157,3 +169,17 @@ func TestWebexClientAccessors(t *testing.T) {
 		t.Error("Internal().Device should not return nil")
 	}
 }
+
+func TestNewClientWithTokenSource(t *testing.T) {
+	client, err := NewClientWithTokenSource(webexsdk.StaticTokenSource("source-token"), nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if client.Core().GetAccessToken() != "source-token" {
+		t.Errorf("Expected 'source-token', got %q", client.Core().GetAccessToken())
+	}
+
+	if _, err := NewClientWithTokenSource(nil, nil); err == nil {
+		t.Error("Expected error for nil token source")
+	}
+}
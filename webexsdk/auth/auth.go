@@ -0,0 +1,34 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+// Package auth obtains access tokens for non-user Webex identities: guest
+// issuer (JWT) guests and service apps. Each helper can produce a
+// webexsdk.RefreshingTokenSource for use with NewClientWithTokenSource.
+package auth
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultBaseURL is the Webex API base URL used for token requests.
+const DefaultBaseURL = "https://webexapis.com/v1"
+
+// httpClientOrDefault returns c, or a client with a 30s timeout if c is nil.
+func httpClientOrDefault(c *http.Client) *http.Client {
+	if c != nil {
+		return c
+	}
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// baseURLOrDefault returns u, or DefaultBaseURL if u is empty.
+func baseURLOrDefault(u string) string {
+	if u != "" {
+		return u
+	}
+	return DefaultBaseURL
+}
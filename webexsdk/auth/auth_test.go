@@ -0,0 +1,187 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+var testSecret = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+func TestGuestIssuer_MintJWT(t *testing.T) {
+	g := &GuestIssuer{IssuerID: "issuer-1", Secret: testSecret, JWTTTL: 10 * time.Minute}
+
+	token, err := g.MintJWT(Guest{Subject: "guest-1", DisplayName: "Guest One"})
+	if err != nil {
+		t.Fatalf("MintJWT failed: %v", err)
+	}
+
+	parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.HS256})
+	if err != nil {
+		t.Fatalf("Failed to parse JWT: %v", err)
+	}
+	key, _ := base64.StdEncoding.DecodeString(testSecret)
+	var claims guestClaims
+	if err := parsed.Claims(key, &claims); err != nil {
+		t.Fatalf("Signature verification failed: %v", err)
+	}
+	if claims.Subject != "guest-1" || claims.Issuer != "issuer-1" || claims.Name != "Guest One" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+	if exp := claims.Expiry.Time(); time.Until(exp) > 11*time.Minute || time.Until(exp) < 9*time.Minute {
+		t.Errorf("Expected expiry ~10m out, got %v", exp)
+	}
+}
+
+func TestGuestIssuer_MintJWTValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		issuer GuestIssuer
+		guest  Guest
+	}{
+		{"missing secret", GuestIssuer{IssuerID: "issuer-1"}, Guest{Subject: "s", DisplayName: "n"}},
+		{"bad secret", GuestIssuer{IssuerID: "issuer-1", Secret: "not base64!"}, Guest{Subject: "s", DisplayName: "n"}},
+		{"missing subject", GuestIssuer{IssuerID: "issuer-1", Secret: testSecret}, Guest{DisplayName: "n"}},
+		{"missing name", GuestIssuer{IssuerID: "issuer-1", Secret: testSecret}, Guest{Subject: "s"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.issuer.MintJWT(tt.guest); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestGuestIssuer_TokenSource(t *testing.T) {
+	var logins int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/jwt/login" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ey") {
+			t.Errorf("Expected guest JWT bearer, got %q", r.Header.Get("Authorization"))
+		}
+		n := atomic.AddInt32(&logins, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"token": "guest-token-%d", "expiresIn": 21600}`, n)
+	}))
+	defer server.Close()
+
+	g := &GuestIssuer{IssuerID: "issuer-1", Secret: testSecret, BaseURL: server.URL, HttpClient: server.Client()}
+	ts := g.TokenSource(Guest{Subject: "guest-1", DisplayName: "Guest One"}, nil)
+
+	client, err := webexsdk.NewClientWithTokenSource(ts, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if got := client.GetAccessToken(); got != "guest-token-1" {
+		t.Errorf("Expected 'guest-token-1', got %q", got)
+	}
+	if got := client.GetAccessToken(); got != "guest-token-1" {
+		t.Errorf("Expected cached 'guest-token-1', got %q", got)
+	}
+
+	if _, err := client.RefreshAccessToken(context.Background()); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if got := client.GetAccessToken(); got != "guest-token-2" {
+		t.Errorf("Expected 'guest-token-2' after refresh, got %q", got)
+	}
+}
+
+func TestGuestIssuer_ExchangeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprintln(w, `{"message": "invalid JWT"}`)
+	}))
+	defer server.Close()
+
+	g := &GuestIssuer{IssuerID: "issuer-1", Secret: testSecret, BaseURL: server.URL, HttpClient: server.Client()}
+	_, err := g.Login(context.Background(), Guest{Subject: "guest-1", DisplayName: "Guest One"})
+	if !webexsdk.IsAuthError(err) {
+		t.Errorf("Expected AuthError, got %v", err)
+	}
+}
+
+func TestServiceApp_RequestTokenAndRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/applications/app-1/token":
+			if r.Header.Get("Authorization") != "Bearer owner-token" {
+				t.Errorf("Expected owner token, got %q", r.Header.Get("Authorization"))
+			}
+			var body applicationTokenRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.ClientID != "client-id" || body.ClientSecret != "secret" || body.TargetOrgID != "org-1" {
+				t.Errorf("Unexpected body: %+v", body)
+			}
+			_, _ = fmt.Fprintln(w, `{"access_token":"org-access-1","expires_in":1209600,"refresh_token":"org-refresh","refresh_token_expires_in":7776000}`)
+		case "/access_token":
+			_ = r.ParseForm()
+			if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != "org-refresh" {
+				t.Errorf("Unexpected form: %v", r.Form)
+			}
+			_, _ = fmt.Fprintln(w, `{"access_token":"org-access-2","expires_in":1209600}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	s := &ServiceApp{
+		ApplicationID: "app-1",
+		ClientID:      "client-id",
+		ClientSecret:  "secret",
+		BaseURL:       server.URL,
+		HttpClient:    server.Client(),
+	}
+
+	token, err := s.RequestToken(context.Background(), "owner-token", "org-1")
+	if err != nil {
+		t.Fatalf("RequestToken failed: %v", err)
+	}
+	if token.AccessToken != "org-access-1" || token.RefreshToken != "org-refresh" {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+
+	// Start from just the stored refresh token
+	ts := s.TokenSource(&webexsdk.Token{RefreshToken: token.RefreshToken}, nil)
+	got, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token failed: %v", err)
+	}
+	if got.AccessToken != "org-access-2" || got.RefreshToken != "org-refresh" {
+		t.Errorf("Unexpected refreshed token: %+v", got)
+	}
+}
+
+func TestServiceApp_RequestTokenValidation(t *testing.T) {
+	s := &ServiceApp{ClientID: "client-id", ClientSecret: "secret"}
+	if _, err := s.RequestToken(context.Background(), "owner-token", "org-1"); err == nil {
+		t.Error("Expected error for missing application ID")
+	}
+	s.ApplicationID = "app-1"
+	if _, err := s.RequestToken(context.Background(), "owner-token", ""); err == nil {
+		t.Error("Expected error for missing target org")
+	}
+}
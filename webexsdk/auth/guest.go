@@ -0,0 +1,172 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// DefaultGuestJWTTTL is how long a minted guest JWT is valid when
+// GuestIssuer.JWTTTL is not set.
+const DefaultGuestJWTTTL = time.Hour
+
+// GuestIssuer mints guest JWTs for a Webex guest issuer app and exchanges
+// them for access tokens.
+type GuestIssuer struct {
+	// IssuerID is the guest issuer app ID, used as the JWT "iss" claim.
+	IssuerID string
+
+	// Secret is the base64-encoded shared secret shown when the guest
+	// issuer app was created.
+	Secret string
+
+	// JWTTTL is the lifetime of minted JWTs. Default: DefaultGuestJWTTTL.
+	JWTTTL time.Duration
+
+	// BaseURL is the Webex API base URL. Default: DefaultBaseURL; set it to
+	// webexsdk.FedRAMP.APIBaseURL for Webex for Government.
+	BaseURL string
+
+	// HttpClient is used for token requests. If nil, a client with a 30s
+	// timeout is used.
+	HttpClient *http.Client
+}
+
+// Guest identifies a guest user.
+type Guest struct {
+	// Subject is a unique, stable ID for the guest within the issuer.
+	Subject string
+
+	// DisplayName is shown to other participants.
+	DisplayName string
+}
+
+// guestClaims are the JWT claims expected by /jwt/login.
+type guestClaims struct {
+	jwt.Claims
+	Name string `json:"name"`
+}
+
+// jwtLoginResponse is the JSON body returned by /jwt/login.
+type jwtLoginResponse struct {
+	Token     string `json:"token"`
+	ExpiresIn int64  `json:"expiresIn"`
+}
+
+// MintJWT returns a signed HS256 guest JWT for guest.
+func (g *GuestIssuer) MintJWT(guest Guest) (string, error) {
+	if g.IssuerID == "" || g.Secret == "" {
+		return "", fmt.Errorf("issuer ID and secret are required")
+	}
+	if guest.Subject == "" {
+		return "", fmt.Errorf("guest subject is required")
+	}
+	if guest.DisplayName == "" {
+		return "", fmt.Errorf("guest display name is required")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(g.Secret)
+	if err != nil {
+		return "", fmt.Errorf("error decoding issuer secret: %w", err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", fmt.Errorf("error creating JWT signer: %w", err)
+	}
+
+	ttl := g.JWTTTL
+	if ttl <= 0 {
+		ttl = DefaultGuestJWTTTL
+	}
+
+	claims := guestClaims{
+		Claims: jwt.Claims{
+			Subject: guest.Subject,
+			Issuer:  g.IssuerID,
+			Expiry:  jwt.NewNumericDate(time.Now().Add(ttl)),
+		},
+		Name: guest.DisplayName,
+	}
+
+	return jwt.Signed(signer).Claims(claims).Serialize()
+}
+
+// Exchange trades a guest JWT for a Webex access token.
+func (g *GuestIssuer) Exchange(ctx context.Context, guestJWT string) (*webexsdk.Token, error) {
+	if guestJWT == "" {
+		return nil, fmt.Errorf("guest JWT is required")
+	}
+
+	loginURL := strings.TrimSuffix(baseURLOrDefault(g.BaseURL), "/") + "/jwt/login"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, loginURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+guestJWT)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClientOrDefault(g.HttpClient).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, webexsdk.NewAPIError(resp, body)
+	}
+
+	var lr jwtLoginResponse
+	if err := json.Unmarshal(body, &lr); err != nil {
+		return nil, fmt.Errorf("error parsing JWT login response: %w", err)
+	}
+	if lr.Token == "" {
+		return nil, fmt.Errorf("JWT login response did not include a token")
+	}
+
+	token := &webexsdk.Token{AccessToken: lr.Token}
+	if lr.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(lr.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// Login mints a JWT for guest and exchanges it for an access token.
+func (g *GuestIssuer) Login(ctx context.Context, guest Guest) (*webexsdk.Token, error) {
+	guestJWT, err := g.MintJWT(guest)
+	if err != nil {
+		return nil, err
+	}
+	return g.Exchange(ctx, guestJWT)
+}
+
+// TokenSource returns a RefreshingTokenSource for guest. Guest tokens have
+// no refresh token, so each refresh mints a new JWT and logs in again. The
+// first token is fetched on first use.
+// If config is nil, the default configuration will be used.
+func (g *GuestIssuer) TokenSource(guest Guest, config *webexsdk.RefreshingTokenSourceConfig) *webexsdk.RefreshingTokenSource {
+	return webexsdk.NewRefreshingTokenSource(nil, func(ctx context.Context, current *webexsdk.Token) (*webexsdk.Token, error) {
+		return g.Login(ctx, guest)
+	}, config)
+}
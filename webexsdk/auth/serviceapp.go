@@ -0,0 +1,111 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// ServiceApp obtains org-scoped access tokens for a Webex service app.
+//
+// An admin of the target org authorizes the service app once; its initial
+// token pair is then fetched with RequestToken. The refresh token is
+// exchanged for new access tokens with Refresh or TokenSource.
+type ServiceApp struct {
+	// ApplicationID is the service app's ID, used by RequestToken.
+	ApplicationID string
+
+	// ClientID and ClientSecret are the service app's credentials.
+	ClientID     string
+	ClientSecret string
+
+	// BaseURL is the Webex API base URL. Default: DefaultBaseURL; set it to
+	// webexsdk.FedRAMP.APIBaseURL for Webex for Government.
+	BaseURL string
+
+	// HttpClient is used for token requests. If nil, a client with a 30s
+	// timeout is used.
+	HttpClient *http.Client
+}
+
+// applicationTokenRequest is the body of POST /applications/{id}/token.
+type applicationTokenRequest struct {
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	TargetOrgID  string `json:"targetOrgId"`
+}
+
+// RequestToken fetches the service app's token pair for targetOrgID.
+// authToken is the access token of the service app's owner (the
+// developer who created it), which is allowed to request its tokens.
+func (s *ServiceApp) RequestToken(ctx context.Context, authToken, targetOrgID string) (*webexsdk.Token, error) {
+	if s.ApplicationID == "" {
+		return nil, fmt.Errorf("application ID is required")
+	}
+	if authToken == "" {
+		return nil, fmt.Errorf("auth token is required")
+	}
+	if targetOrgID == "" {
+		return nil, fmt.Errorf("target org ID is required")
+	}
+
+	body, err := json.Marshal(applicationTokenRequest{
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		TargetOrgID:  targetOrgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := fmt.Sprintf("%s/applications/%s/token",
+		strings.TrimSuffix(baseURLOrDefault(s.BaseURL), "/"), url.PathEscape(s.ApplicationID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+authToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClientOrDefault(s.HttpClient).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return webexsdk.ParseTokenResponse(resp)
+}
+
+// Refresh exchanges refreshToken for a new org-scoped access token.
+func (s *ServiceApp) Refresh(ctx context.Context, refreshToken string) (*webexsdk.Token, error) {
+	return s.oauthConfig().Refresh(ctx, refreshToken)
+}
+
+// TokenSource returns a RefreshingTokenSource that starts from token and
+// renews it with its refresh token before it expires. token only needs a
+// RefreshToken; the access token is then fetched on first use.
+// If config is nil, the default configuration will be used.
+func (s *ServiceApp) TokenSource(token *webexsdk.Token, config *webexsdk.RefreshingTokenSourceConfig) *webexsdk.RefreshingTokenSource {
+	return s.oauthConfig().TokenSource(token, config)
+}
+
+// oauthConfig returns the OAuthConfig used for refresh-token grants.
+func (s *ServiceApp) oauthConfig() *webexsdk.OAuthConfig {
+	return &webexsdk.OAuthConfig{
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		TokenURL:     strings.TrimSuffix(baseURLOrDefault(s.BaseURL), "/") + "/access_token",
+		HttpClient:   s.HttpClient,
+	}
+}
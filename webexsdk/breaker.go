@@ -0,0 +1,256 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of one circuit of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through and counts consecutive failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests with ErrCircuitOpen without sending them.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial requests through to
+	// decide whether to close or reopen the circuit.
+	CircuitHalfOpen
+)
+
+// String returns the state's name.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerConfig holds the configuration for a CircuitBreaker
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens a
+	// circuit. Default: 5.
+	FailureThreshold int
+
+	// OpenTimeout is how long a circuit stays open before it lets trial
+	// requests through. Default: 30s.
+	OpenTimeout time.Duration
+
+	// HalfOpenMaxRequests is the number of trial requests let through
+	// while half-open. The circuit closes once that many have succeeded
+	// and reopens on the first failure. Default: 1.
+	HalfOpenMaxRequests int
+
+	// IsFailure reports whether the outcome of a request counts as a
+	// failure. If nil, transport errors and 5xx responses are failures;
+	// context cancellation and 429 responses, which the RateLimiter and
+	// retries handle, are not.
+	IsFailure func(resp *http.Response, err error) bool
+
+	// OnStateChange, if set, is called after a circuit changes state. It
+	// is called synchronously from the request that caused the change and
+	// should not block.
+	OnStateChange func(key string, from, to CircuitState)
+}
+
+// CircuitBreaker stops sending requests to an endpoint that keeps failing,
+// so that callers fail fast with ErrCircuitOpen instead of waiting on
+// timeouts and retries. Each endpoint has its own circuit, keyed by host
+// and, for the API host, resource (e.g. "webexapis.com/messages"). Other
+// hosts, such as Mobius servers, have one circuit per host.
+//
+// A CircuitBreaker is safe for concurrent use and may be shared by several
+// clients through Config.CircuitBreaker.
+type CircuitBreaker struct {
+	config *CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the state of one key.
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	// generation changes on every transition so that requests admitted in
+	// an earlier state do not count towards the current one.
+	generation uint64
+	probes     int
+	successes  int
+}
+
+// NewCircuitBreaker creates a new CircuitBreaker.
+// If config is nil, the defaults are used.
+func NewCircuitBreaker(config *CircuitBreakerConfig) *CircuitBreaker {
+	if config == nil {
+		config = &CircuitBreakerConfig{}
+	}
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = 5
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = 30 * time.Second
+	}
+	if config.HalfOpenMaxRequests <= 0 {
+		config.HalfOpenMaxRequests = 1
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = isCircuitFailure
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// State returns the state of the circuit for key. An open circuit whose
+// OpenTimeout has passed is reported as half-open.
+func (b *CircuitBreaker) State(key string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[key]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= b.config.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// Allow reports whether a request to key may be sent. If so, it returns a
+// done function that must be called with the request's outcome; if not,
+// it returns a *CircuitOpenError.
+func (b *CircuitBreaker) Allow(key string) (done func(resp *http.Response, err error), err error) {
+	b.mu.Lock()
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+
+	var from CircuitState
+	changed := false
+	now := time.Now()
+
+	switch c.state {
+	case CircuitOpen:
+		if wait := b.config.OpenTimeout - now.Sub(c.openedAt); wait > 0 {
+			b.mu.Unlock()
+			return nil, &CircuitOpenError{Key: key, State: CircuitOpen, RetryAfter: wait}
+		}
+		from, changed = c.state, true
+		c.transition(CircuitHalfOpen, now)
+		c.probes++
+	case CircuitHalfOpen:
+		if c.probes >= b.config.HalfOpenMaxRequests {
+			b.mu.Unlock()
+			return nil, &CircuitOpenError{Key: key, State: CircuitHalfOpen}
+		}
+		c.probes++
+	}
+	generation := c.generation
+	b.mu.Unlock()
+
+	if changed {
+		b.notify(key, from, CircuitHalfOpen)
+	}
+
+	var once sync.Once
+	return func(resp *http.Response, err error) {
+		once.Do(func() { b.record(key, generation, b.config.IsFailure(resp, err)) })
+	}, nil
+}
+
+// record counts the outcome of a request admitted in generation.
+func (b *CircuitBreaker) record(key string, generation uint64, failure bool) {
+	b.mu.Lock()
+	c := b.circuits[key]
+	if c.generation != generation {
+		b.mu.Unlock()
+		return
+	}
+
+	from := c.state
+	now := time.Now()
+	switch c.state {
+	case CircuitClosed:
+		if !failure {
+			c.failures = 0
+			break
+		}
+		c.failures++
+		if c.failures >= b.config.FailureThreshold {
+			c.transition(CircuitOpen, now)
+		}
+	case CircuitHalfOpen:
+		c.probes--
+		if failure {
+			c.transition(CircuitOpen, now)
+			break
+		}
+		c.successes++
+		if c.successes >= b.config.HalfOpenMaxRequests {
+			c.transition(CircuitClosed, now)
+		}
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	if to != from {
+		b.notify(key, from, to)
+	}
+}
+
+// transition moves c to state and resets its counters.
+func (c *circuit) transition(state CircuitState, now time.Time) {
+	c.state = state
+	c.generation++
+	c.failures = 0
+	c.probes = 0
+	c.successes = 0
+	if state == CircuitOpen {
+		c.openedAt = now
+	}
+}
+
+// notify calls OnStateChange, if set.
+func (b *CircuitBreaker) notify(key string, from, to CircuitState) {
+	if b.config.OnStateChange != nil {
+		b.config.OnStateChange(key, from, to)
+	}
+}
+
+// isCircuitFailure is the default CircuitBreakerConfig.IsFailure.
+func isCircuitFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode >= 500
+}
+
+// circuitKey returns the CircuitBreaker key for req.
+func (c *Client) circuitKey(req *http.Request) string {
+	if req.URL.Host != c.BaseURL.Host {
+		return req.URL.Host
+	}
+	return req.URL.Host + "/" + string(resourceFromURL(req.URL, c.BaseURL.Path))
+}
@@ -0,0 +1,192 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+type stateChange struct {
+	key      string
+	from, to CircuitState
+}
+
+func TestCircuitBreaker_Transitions(t *testing.T) {
+	var mu sync.Mutex
+	var changes []stateChange
+	cb := NewCircuitBreaker(&CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenTimeout:      20 * time.Millisecond,
+		OnStateChange: func(key string, from, to CircuitState) {
+			mu.Lock()
+			changes = append(changes, stateChange{key, from, to})
+			mu.Unlock()
+		},
+	})
+
+	failing := true
+	requests := 0
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, &Config{CircuitBreaker: cb})
+	client.Config.MaxRetries = 0
+
+	get := func() error {
+		resp, err := client.RequestWithRetry(context.Background(), http.MethodGet, "messages", nil, nil)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+	key := client.BaseURL.Host + "/messages"
+
+	// Two failures open the circuit
+	for i := 0; i < 2; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if cb.State(key) != CircuitOpen {
+		t.Fatalf("Expected open circuit, got %v", cb.State(key))
+	}
+
+	// Open circuit fails fast without reaching the server
+	err := get()
+	var open *CircuitOpenError
+	if !errors.As(err, &open) || !errors.Is(err, ErrCircuitOpen) || !IsCircuitOpen(err) {
+		t.Fatalf("Expected CircuitOpenError, got %v", err)
+	}
+	if open.Key != key || open.RetryAfter <= 0 {
+		t.Errorf("Unexpected error fields: %+v", open)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests to reach the server, got %d", requests)
+	}
+
+	// Other resources have their own circuit
+	resp, err := client.RequestWithRetry(context.Background(), http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Expected rooms circuit to be closed, got %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// A failed trial request reopens the circuit
+	time.Sleep(25 * time.Millisecond)
+	if cb.State(key) != CircuitHalfOpen {
+		t.Errorf("Expected half-open circuit, got %v", cb.State(key))
+	}
+	_ = get()
+	if cb.State(key) != CircuitOpen {
+		t.Fatalf("Expected reopened circuit, got %v", cb.State(key))
+	}
+
+	// A successful trial request closes it
+	time.Sleep(25 * time.Millisecond)
+	failing = false
+	if err := get(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cb.State(key) != CircuitClosed {
+		t.Errorf("Expected closed circuit, got %v", cb.State(key))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []stateChange{
+		{key, CircuitClosed, CircuitOpen},
+		{key, CircuitOpen, CircuitHalfOpen},
+		{key, CircuitHalfOpen, CircuitOpen},
+		{key, CircuitOpen, CircuitHalfOpen},
+		{key, CircuitHalfOpen, CircuitClosed},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d state changes, got %v", len(want), changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Change %d: expected %v, got %v", i, want[i], changes[i])
+		}
+	}
+}
+
+func TestCircuitBreaker_HalfOpenLimit(t *testing.T) {
+	cb := NewCircuitBreaker(&CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Millisecond, HalfOpenMaxRequests: 2})
+
+	done, _ := cb.Allow("k")
+	done(nil, errors.New("connection reset"))
+	time.Sleep(2 * time.Millisecond)
+
+	first, err := cb.Allow("k")
+	if err != nil {
+		t.Fatalf("Expected trial request, got %v", err)
+	}
+	second, err := cb.Allow("k")
+	if err != nil {
+		t.Fatalf("Expected second trial request, got %v", err)
+	}
+	if _, err := cb.Allow("k"); !IsCircuitOpen(err) {
+		t.Errorf("Expected third request to be rejected, got %v", err)
+	}
+
+	ok := &http.Response{StatusCode: http.StatusOK}
+	first(ok, nil)
+	if cb.State("k") != CircuitHalfOpen {
+		t.Errorf("Expected half-open after one success, got %v", cb.State("k"))
+	}
+	second(ok, nil)
+	if cb.State("k") != CircuitClosed {
+		t.Errorf("Expected closed after two successes, got %v", cb.State("k"))
+	}
+}
+
+func TestCircuitBreaker_NotFailures(t *testing.T) {
+	cb := NewCircuitBreaker(&CircuitBreakerConfig{FailureThreshold: 1})
+
+	for _, outcome := range []struct {
+		resp *http.Response
+		err  error
+	}{
+		{&http.Response{StatusCode: http.StatusTooManyRequests}, nil},
+		{&http.Response{StatusCode: http.StatusNotFound}, nil},
+		{nil, context.Canceled},
+	} {
+		done, err := cb.Allow("k")
+		if err != nil {
+			t.Fatalf("Unexpected rejection: %v", err)
+		}
+		done(outcome.resp, outcome.err)
+	}
+	if cb.State("k") != CircuitClosed {
+		t.Errorf("Expected closed circuit, got %v", cb.State("k"))
+	}
+}
+
+func TestClient_CircuitKey(t *testing.T) {
+	client, _ := NewClient("token", &Config{BaseURL: "https://webexapis.com/v1"})
+
+	for raw, want := range map[string]string{
+		"https://webexapis.com/v1/team/memberships?max=1":  "webexapis.com/team/memberships",
+		"https://webexapis.com/v1/messages/abc":            "webexapis.com/messages",
+		"https://mobius.example/api/v1/calling/web/device": "mobius.example",
+	} {
+		u, _ := url.Parse(raw)
+		if got := client.circuitKey(&http.Request{URL: u}); got != want {
+			t.Errorf("circuitKey(%s) = %q, want %q", raw, got, want)
+		}
+	}
+}
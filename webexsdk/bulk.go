@@ -0,0 +1,290 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrBulkStopped is the error of the items RunBulk did not start because an
+// earlier item failed and BulkConfig.StopOnError is set.
+var ErrBulkStopped = errors.New("bulk operation stopped after a failure")
+
+// BulkConfig holds the configuration for RunBulk
+type BulkConfig struct {
+	// Concurrency is the number of items processed at once. Requests still
+	// go through the client's RateLimiter, if any. Default: 4.
+	Concurrency int
+
+	// MaxAttempts is the number of times an item is tried when it fails
+	// with a 429, a 5xx or a transient network error that outlasted the
+	// client's own retries. A 429 pauses every worker for its
+	// Retry-After. Set it to 1 for operations that are not safe to repeat.
+	// Default: 3.
+	MaxAttempts int
+
+	// RetryDelay is the wait before the second attempt at an item whose
+	// error has no Retry-After; it doubles with each further attempt.
+	// Default: 1s.
+	RetryDelay time.Duration
+
+	// Skip reports whether an item's error means there was nothing to do,
+	// e.g. IsConflict for adding a person who is already a member. Such
+	// items are reported as skipped rather than failed.
+	Skip func(err error) bool
+
+	// StopOnError stops starting new items after the first failure. The
+	// items not started are reported as skipped with ErrBulkStopped.
+	StopOnError bool
+}
+
+// BulkStatus is the outcome of one item of RunBulk.
+type BulkStatus int
+
+const (
+	// BulkSkipped means the item was not started, or failed with an error
+	// accepted by BulkConfig.Skip.
+	BulkSkipped BulkStatus = iota
+	// BulkSucceeded means the operation succeeded.
+	BulkSucceeded
+	// BulkFailed means the operation failed on its last attempt.
+	BulkFailed
+)
+
+// String returns the status's name.
+func (s BulkStatus) String() string {
+	switch s {
+	case BulkSkipped:
+		return "skipped"
+	case BulkSucceeded:
+		return "succeeded"
+	case BulkFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// BulkResult is the outcome of one item of RunBulk.
+type BulkResult[In, Out any] struct {
+	// Index is the item's position in the input.
+	Index int
+	Item  In
+
+	Status BulkStatus
+
+	// Value is the operation's result, if it succeeded.
+	Value Out
+
+	// Err is the error of a failed item, as returned by the operation
+	// (e.g. a *RateLimitError or *NotFoundError), or why an item was
+	// skipped: the error accepted by Skip, the context's error or
+	// ErrBulkStopped.
+	Err error
+
+	// Attempts is the number of times the operation was called.
+	Attempts int
+}
+
+// BulkReport holds the outcome of every item of RunBulk, in input order.
+type BulkReport[In, Out any] struct {
+	Results []BulkResult[In, Out]
+}
+
+// Succeeded returns the results of the items that succeeded.
+func (r *BulkReport[In, Out]) Succeeded() []BulkResult[In, Out] {
+	return r.withStatus(BulkSucceeded)
+}
+
+// Failed returns the results of the items that failed.
+func (r *BulkReport[In, Out]) Failed() []BulkResult[In, Out] {
+	return r.withStatus(BulkFailed)
+}
+
+// Skipped returns the results of the items that were skipped.
+func (r *BulkReport[In, Out]) Skipped() []BulkResult[In, Out] {
+	return r.withStatus(BulkSkipped)
+}
+
+func (r *BulkReport[In, Out]) withStatus(status BulkStatus) []BulkResult[In, Out] {
+	var results []BulkResult[In, Out]
+	for _, result := range r.Results {
+		if result.Status == status {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Err returns the errors of the failed items joined, each prefixed with
+// the item, or nil if no item failed. Skipped items are not errors.
+func (r *BulkReport[In, Out]) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%v: %w", result.Item, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// RunBulk calls op for every item with bounded concurrency and reports the
+// outcome per item, so that a partial failure does not lose track of what
+// was done. Items that fail with a 429 or another retryable error are
+// retried; other errors fail the item and RunBulk carries on with the
+// rest, unless StopOnError is set. Once ctx is done, the items not started
+// are skipped with ctx's error.
+//
+// op is called from several goroutines at once. A nil config uses the
+// defaults.
+func RunBulk[In, Out any](ctx context.Context, items []In, op func(ctx context.Context, item In) (Out, error), config *BulkConfig) *BulkReport[In, Out] {
+	cfg := BulkConfig{}
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = time.Second
+	}
+
+	report := &BulkReport[In, Out]{Results: make([]BulkResult[In, Out], len(items))}
+	for i, item := range items {
+		report.Results[i] = BulkResult[In, Out]{Index: i, Item: item}
+	}
+
+	run := &bulkRun[In, Out]{config: &cfg, op: op}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(cfg.Concurrency, len(items)) {
+		wg.Go(func() {
+			for i := range indexes {
+				run.do(ctx, &report.Results[i])
+			}
+		})
+	}
+feed:
+	for i := range items {
+		if run.stopped.Load() {
+			break
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := range report.Results {
+		result := &report.Results[i]
+		if result.Attempts == 0 && result.Err == nil {
+			if err := ctx.Err(); err != nil {
+				result.Err = err
+			} else {
+				result.Err = ErrBulkStopped
+			}
+		}
+	}
+	return report
+}
+
+// bulkRun is the state shared by the workers of one RunBulk.
+type bulkRun[In, Out any] struct {
+	config  *BulkConfig
+	op      func(ctx context.Context, item In) (Out, error)
+	stopped atomic.Bool
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// do processes one item, retrying it as configured.
+func (b *bulkRun[In, Out]) do(ctx context.Context, result *BulkResult[In, Out]) {
+	if b.stopped.Load() {
+		return
+	}
+	delay := b.config.RetryDelay
+	for {
+		if err := b.waitPause(ctx); err != nil {
+			if result.Attempts > 0 {
+				result.Status = BulkFailed
+			} else {
+				result.Err = err
+			}
+			return
+		}
+
+		result.Attempts++
+		result.Value, result.Err = b.op(ctx, result.Item)
+		switch {
+		case result.Err == nil:
+			result.Status = BulkSucceeded
+			return
+		case b.config.Skip != nil && b.config.Skip(result.Err):
+			result.Status = BulkSkipped
+			return
+		case result.Attempts < b.config.MaxAttempts && ctx.Err() == nil && bulkRetryable(result.Err):
+			wait := delay
+			delay *= 2
+			var apiErr *APIError
+			if errors.As(result.Err, &apiErr) && apiErr.RetryAfter > 0 {
+				wait = apiErr.RetryAfter
+			}
+			if IsRateLimited(result.Err) {
+				b.pause(wait)
+			} else if err := sleepCtx(ctx, wait); err != nil {
+				result.Status = BulkFailed
+				return
+			}
+		default:
+			result.Status = BulkFailed
+			if b.config.StopOnError {
+				b.stopped.Store(true)
+			}
+			return
+		}
+	}
+}
+
+// pause holds back every worker for d.
+func (b *bulkRun[In, Out]) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// waitPause waits until no pause is in effect or ctx is done.
+func (b *bulkRun[In, Out]) waitPause(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		d := time.Until(b.pausedUntil)
+		b.mu.Unlock()
+		if d <= 0 {
+			return ctx.Err()
+		}
+		if err := sleepCtx(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// bulkRetryable reports whether an item that failed with err is worth
+// trying again.
+func bulkRetryable(err error) bool {
+	return IsRateLimited(err) || IsServerError(err) || IsTransientError(err)
+}
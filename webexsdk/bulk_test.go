@@ -0,0 +1,125 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBulk(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	var inFlight, maxInFlight atomic.Int32
+
+	op := func(ctx context.Context, item string) (string, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		calls[item]++
+		attempt := calls[item]
+		mu.Unlock()
+
+		switch item {
+		case "throttled":
+			if attempt == 1 {
+				return "", &RateLimitError{APIError: &APIError{StatusCode: 429, RetryAfter: 10 * time.Millisecond}}
+			}
+		case "missing":
+			return "", &NotFoundError{APIError: &APIError{StatusCode: 404}}
+		case "member":
+			return "", &ConflictError{APIError: &APIError{StatusCode: 409}}
+		case "flaky":
+			return "", &ServerError{APIError: &APIError{StatusCode: 503}}
+		}
+		return "done:" + item, nil
+	}
+
+	items := []string{"a", "throttled", "missing", "b", "member", "flaky", "c"}
+	report := RunBulk(context.Background(), items, op, &BulkConfig{
+		Concurrency: 3,
+		RetryDelay:  time.Millisecond,
+		Skip:        IsConflict,
+	})
+
+	if len(report.Results) != len(items) {
+		t.Fatalf("Expected %d results, got %d", len(items), len(report.Results))
+	}
+	for i, result := range report.Results {
+		if result.Index != i || result.Item != items[i] {
+			t.Errorf("Result %d out of order: %+v", i, result)
+		}
+	}
+	if got := len(report.Succeeded()); got != 4 {
+		t.Errorf("Expected 4 succeeded, got %d", got)
+	}
+	if r := report.Results[1]; r.Status != BulkSucceeded || r.Attempts != 2 || r.Value != "done:throttled" {
+		t.Errorf("Expected the 429 to be retried, got %+v", r)
+	}
+	if r := report.Results[2]; r.Status != BulkFailed || r.Attempts != 1 || !IsNotFound(r.Err) {
+		t.Errorf("Expected the 404 to fail without retry, got %+v", r)
+	}
+	if r := report.Results[4]; r.Status != BulkSkipped || !IsConflict(r.Err) {
+		t.Errorf("Expected the 409 to be skipped, got %+v", r)
+	}
+	if r := report.Results[5]; r.Status != BulkFailed || r.Attempts != 3 || !IsServerError(r.Err) {
+		t.Errorf("Expected the 503 to fail after 3 attempts, got %+v", r)
+	}
+	if maxInFlight.Load() > 3 {
+		t.Errorf("Expected at most 3 items in flight, got %d", maxInFlight.Load())
+	}
+
+	err := report.Err()
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "missing:") || !strings.Contains(err.Error(), "flaky:") {
+		t.Errorf("Unexpected joined error: %v", err)
+	}
+}
+
+func TestRunBulkStop(t *testing.T) {
+	failing := errors.New("invalid item")
+	op := func(ctx context.Context, item int) (int, error) {
+		if item == 0 {
+			return 0, failing
+		}
+		return item, nil
+	}
+
+	report := RunBulk(context.Background(), []int{0, 1, 2, 3}, op, &BulkConfig{Concurrency: 1, StopOnError: true})
+	if r := report.Results[0]; r.Status != BulkFailed || !errors.Is(r.Err, failing) {
+		t.Errorf("Expected the first item to fail, got %+v", r)
+	}
+	for _, r := range report.Results[2:] {
+		if r.Status != BulkSkipped || !errors.Is(r.Err, ErrBulkStopped) || r.Attempts != 0 {
+			t.Errorf("Expected item %d to be skipped, got %+v", r.Item, r)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report = RunBulk(ctx, []int{1, 2}, op, nil)
+	for _, r := range report.Skipped() {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", r.Err)
+		}
+	}
+	if len(report.Skipped()) != 2 || report.Err() != nil {
+		t.Errorf("Expected both items skipped without error, got %+v", report.Results)
+	}
+}
@@ -0,0 +1,394 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheEntry is a cached GET response.
+type CacheEntry struct {
+	// Header is the response header.
+	Header http.Header
+
+	// Body is the response body.
+	Body []byte
+
+	// ETag is the entity tag used to revalidate the entry, if any.
+	ETag string
+
+	// Expires is when the entry stops being fresh. After that it is
+	// revalidated with If-None-Match if it has an ETag, or refetched.
+	Expires time.Time
+}
+
+// CacheStore stores cache entries. Implementations must be safe for
+// concurrent use; they may evict entries at any time.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+
+	// DeletePrefix deletes every entry whose key starts with prefix.
+	DeletePrefix(prefix string)
+}
+
+// CacheConfig holds the configuration for a Cache.
+type CacheConfig struct {
+	// Store holds the entries. Default: an LRUCacheStore of MaxEntries.
+	Store CacheStore
+
+	// MaxEntries is the capacity of the default store. Default: 1000.
+	MaxEntries int
+
+	// DefaultTTL is how long responses of resources without an entry in
+	// Resources stay fresh. Zero means such resources are not cached.
+	DefaultTTL time.Duration
+
+	// Resources overrides the TTL for individual resources, e.g.
+	// ResourcePeople or ResourceRooms. Zero or a negative value disables
+	// caching for the resource.
+	Resources map[Resource]time.Duration
+
+	// MaxBodySize is the largest response body that is cached, in bytes.
+	// Default: 1 MiB.
+	MaxBodySize int64
+}
+
+// CacheStats counts the outcomes of requests seen by a Cache.
+type CacheStats struct {
+	// Hits is the number of requests answered from a fresh entry.
+	Hits int64
+
+	// Revalidations is the number of requests answered from an entry
+	// after the server confirmed it with 304 Not Modified.
+	Revalidations int64
+
+	// Misses is the number of cacheable requests that had to be fetched.
+	Misses int64
+
+	// Invalidations is the number of mutating requests, each of which
+	// invalidated the entries for its path.
+	Invalidations int64
+}
+
+// Cache is an opt-in response cache for GET requests to the API host. It
+// answers repeated requests from fresh entries, revalidates stale entries
+// with If-None-Match and honours the Cache-Control header of responses:
+// no-store responses are not cached, no-cache responses are revalidated on
+// every use, and max-age shortens the configured TTL. A POST, PUT, PATCH or
+// DELETE request sent through the client invalidates the entries for the
+// same path and for the collection it belongs to, e.g. updating a room
+// invalidates the cached GET rooms/{id} and every cached GET rooms.
+//
+// Entries are keyed by URL and access token, so a Cache is safe for
+// concurrent use and may be shared by clients through Config.Cache.
+type Cache struct {
+	config *CacheConfig
+	store  CacheStore
+
+	hits          atomic.Int64
+	revalidations atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+}
+
+// NewCache creates a new Cache.
+// If config is nil, nothing is cached until DefaultTTL or Resources are
+// set, but mutating requests still invalidate entries.
+func NewCache(config *CacheConfig) *Cache {
+	if config == nil {
+		config = &CacheConfig{}
+	}
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = 1000
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 1 << 20
+	}
+
+	store := config.Store
+	if store == nil {
+		store = NewLRUCacheStore(config.MaxEntries)
+	}
+	return &Cache{config: config, store: store}
+}
+
+// Stats returns the counters of the cache.
+func (c *Cache) Stats() CacheStats {
+	return CacheStats{
+		Hits:          c.hits.Load(),
+		Revalidations: c.revalidations.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
+
+// Invalidate deletes the entries for the URL of a resource and of its
+// collection, with any query, e.g. after changing it outside the SDK.
+func (c *Cache) Invalidate(rawURL string) {
+	if u, err := url.Parse(rawURL); err == nil {
+		c.invalidate(u)
+	}
+}
+
+// Purge deletes every entry.
+func (c *Cache) Purge() {
+	c.store.DeletePrefix("")
+}
+
+// invalidate deletes the entries for the path of u and for its parent
+// path, the collection listing it: changing memberships/{id} changes the
+// results of GET memberships as well.
+func (c *Cache) invalidate(u *url.URL) {
+	c.store.DeletePrefix(u.Host + u.Path + "?")
+	if parent := path.Dir(strings.TrimSuffix(u.Path, "/")); parent != "/" && parent != "." {
+		c.store.DeletePrefix(u.Host + parent + "?")
+	}
+}
+
+// ttl returns the TTL of resource.
+func (c *Cache) ttl(resource Resource) time.Duration {
+	if ttl, ok := c.config.Resources[resource]; ok {
+		return ttl
+	}
+	return c.config.DefaultTTL
+}
+
+type cacheBypassKey struct{}
+
+// WithCacheBypass returns a context whose GET requests skip fresh cache
+// entries and go to the server. The responses are still cached.
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+// roundTrip sends req with next, answering it from the cache if possible.
+func (c *Cache) roundTrip(req *http.Request, resource Resource, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodHead, http.MethodOptions:
+		return next(req)
+	default:
+		resp, err := next(req)
+		c.invalidate(req.URL)
+		c.invalidations.Add(1)
+		return resp, err
+	}
+
+	ttl := c.ttl(resource)
+	if ttl <= 0 || req.Header.Get("Range") != "" || req.Header.Get("If-None-Match") != "" {
+		return next(req)
+	}
+
+	key := cacheKey(req)
+	entry, ok := c.store.Get(key)
+	if ok && time.Now().Before(entry.Expires) && req.Context().Value(cacheBypassKey{}) == nil {
+		c.hits.Add(1)
+		return entry.response(req), nil
+	}
+	if ok && entry.ETag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && ok && entry.ETag != "" {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		c.revalidations.Add(1)
+
+		refreshed := *entry
+		lifetime, _ := freshness(resp.Header.Get("Cache-Control"), ttl)
+		refreshed.Expires = time.Now().Add(lifetime)
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			refreshed.ETag = etag
+		}
+		c.store.Set(key, &refreshed)
+		return refreshed.response(req), nil
+	}
+
+	c.misses.Add(1)
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	lifetime, store := freshness(resp.Header.Get("Cache-Control"), ttl)
+	etag := resp.Header.Get("ETag")
+	if !store || (lifetime <= 0 && etag == "") || resp.ContentLength > c.config.MaxBodySize {
+		c.store.Delete(key)
+		return resp, nil
+	}
+
+	// Buffer the body, giving up on caching if it is too large
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.config.MaxBodySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	if int64(len(body)) > c.config.MaxBodySize {
+		resp.Body = readCloser{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.store.Set(key, &CacheEntry{
+		Header:  resp.Header.Clone(),
+		Body:    body,
+		ETag:    etag,
+		Expires: time.Now().Add(lifetime),
+	})
+	return resp, nil
+}
+
+// response returns a 200 response for req with the entry's content.
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheKey returns the key of req: its host, path and query, and a hash
+// of its Authorization header so that users never see each other's
+// responses.
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return req.URL.Host + req.URL.Path + "?" + req.URL.RawQuery + "#" + hex.EncodeToString(sum[:8])
+}
+
+// freshness returns how long a response with the Cache-Control header
+// cacheControl stays fresh given ttl, and whether it may be stored.
+func freshness(cacheControl string, ttl time.Duration) (time.Duration, bool) {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return 0, false
+		case "no-cache":
+			ttl = 0
+		case "max-age":
+			if secs, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil {
+				ttl = min(ttl, time.Duration(secs)*time.Second)
+			}
+		}
+	}
+	return ttl, true
+}
+
+// readCloser combines a Reader with the Closer of the underlying body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// LRUCacheStore is an in-memory CacheStore that evicts the least recently
+// used entry once it holds its maximum number of entries.
+type LRUCacheStore struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	ll      *list.List
+	entries map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCacheStore creates an LRUCacheStore holding up to maxEntries
+// entries (at least 1).
+func NewLRUCacheStore(maxEntries int) *LRUCacheStore {
+	return &LRUCacheStore{
+		maxEntries: max(maxEntries, 1),
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements CacheStore.
+func (s *LRUCacheStore) Get(key string) (*CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	s.ll.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set implements CacheStore.
+func (s *LRUCacheStore) Set(key string, entry *CacheEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		s.ll.MoveToFront(el)
+		return
+	}
+	s.entries[key] = s.ll.PushFront(&lruItem{key: key, entry: entry})
+	for s.ll.Len() > s.maxEntries {
+		oldest := s.ll.Back()
+		s.ll.Remove(oldest)
+		delete(s.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete implements CacheStore.
+func (s *LRUCacheStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[key]; ok {
+		s.ll.Remove(el)
+		delete(s.entries, key)
+	}
+}
+
+// DeletePrefix implements CacheStore.
+func (s *LRUCacheStore) DeletePrefix(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, el := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.ll.Remove(el)
+			delete(s.entries, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the store.
+func (s *LRUCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ll.Len()
+}
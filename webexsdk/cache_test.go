@@ -0,0 +1,207 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCache_HitsAndRevalidation(t *testing.T) {
+	cache := NewCache(&CacheConfig{
+		Resources: map[Resource]time.Duration{ResourceRooms: 20 * time.Millisecond},
+	})
+
+	requests, notModified := 0, 0
+	version := "v1"
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		etag := `"` + version + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(`{"id":"room-1","title":"` + version + `"}`))
+	}, &Config{Cache: cache})
+
+	get := func(ctx context.Context) string {
+		t.Helper()
+		resp, err := client.RequestWithRetry(ctx, http.MethodGet, "rooms/room-1", nil, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected 200, got %d", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	first := get(context.Background())
+	if got := get(context.Background()); got != first || requests != 1 {
+		t.Fatalf("Expected cached response without a request, got %q after %d requests", got, requests)
+	}
+
+	// A stale entry is revalidated with If-None-Match
+	time.Sleep(25 * time.Millisecond)
+	if got := get(context.Background()); got != first || notModified != 1 {
+		t.Errorf("Expected revalidated response, got %q with %d 304s", got, notModified)
+	}
+
+	// WithCacheBypass skips the fresh entry
+	get(WithCacheBypass(context.Background()))
+	if requests != 3 {
+		t.Errorf("Expected bypass to reach the server, got %d requests", requests)
+	}
+
+	// Updating the room invalidates its entry
+	version = "v2"
+	resp, err := client.RequestWithRetry(context.Background(), http.MethodPut, "rooms/room-1", nil, map[string]string{"title": "v2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := get(context.Background()); got != `{"id":"room-1","title":"v2"}` {
+		t.Errorf("Expected fresh response after update, got %q", got)
+	}
+
+	stats := cache.Stats()
+	want := CacheStats{Hits: 1, Revalidations: 2, Misses: 2, Invalidations: 1}
+	if stats != want {
+		t.Errorf("Expected stats %+v, got %+v", want, stats)
+	}
+}
+
+func TestCache_MutationInvalidatesCollection(t *testing.T) {
+	cache := NewCache(&CacheConfig{DefaultTTL: time.Minute})
+
+	members := []string{`{"id":"m1"}`}
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			members = append(members, `{"id":"m2"}`)
+		case r.Method == http.MethodDelete:
+			members = members[1:]
+		}
+		_, _ = w.Write([]byte(`{"items":[` + strings.Join(members, ",") + `]}`))
+	}, &Config{Cache: cache})
+
+	send := func(method, path string, params url.Values) string {
+		t.Helper()
+		resp, err := client.RequestWithRetry(context.Background(), method, path, params, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+	list := func() string {
+		return send(http.MethodGet, "memberships", url.Values{"roomId": {"room-1"}})
+	}
+
+	list()
+	send(http.MethodPost, "memberships", nil)
+	if got := list(); got != `{"items":[{"id":"m1"},{"id":"m2"}]}` {
+		t.Errorf("Expected the created membership in the list, got %s", got)
+	}
+
+	send(http.MethodDelete, "memberships/m1", nil)
+	if got := list(); got != `{"items":[{"id":"m2"}]}` {
+		t.Errorf("Expected the deleted membership gone from the list, got %s", got)
+	}
+}
+
+func TestCache_NotCached(t *testing.T) {
+	cache := NewCache(&CacheConfig{
+		DefaultTTL: time.Minute,
+		Resources:  map[Resource]time.Duration{ResourceMessages: 0},
+	})
+
+	requests := map[string]int{}
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/people/no-store":
+			w.Header().Set("Cache-Control", "no-store")
+		case "/people/missing":
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}, &Config{Cache: cache})
+	client.Config.MaxRetries = 0
+
+	for _, path := range []string{"messages/m1", "people/no-store", "people/missing"} {
+		for i := 0; i < 2; i++ {
+			resp, err := client.RequestWithRetry(context.Background(), http.MethodGet, path, nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+		}
+		if requests["/"+path] != 2 {
+			t.Errorf("Expected %s to be fetched twice, got %d", path, requests["/"+path])
+		}
+	}
+}
+
+func TestCache_KeyedByToken(t *testing.T) {
+	cache := NewCache(&CacheConfig{DefaultTTL: time.Minute})
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}
+	alice := newRetryTestClient(t, handler, &Config{Cache: cache})
+	bob, _ := NewClient("other-token", &Config{BaseURL: alice.BaseURL.String(), HttpClient: alice.httpClient, Cache: cache})
+
+	for _, client := range []*Client{alice, bob, alice, bob} {
+		resp, err := client.RequestWithRetry(context.Background(), http.MethodGet, "people/me", nil, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if want := "Bearer " + client.GetAccessToken(); string(body) != want {
+			t.Errorf("Expected %q, got %q", want, body)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected one request per token, got %d", requests)
+	}
+}
+
+func TestLRUCacheStore(t *testing.T) {
+	s := NewLRUCacheStore(2)
+	s.Set("host/rooms/a?", &CacheEntry{})
+	s.Set("host/rooms/b?", &CacheEntry{})
+	s.Get("host/rooms/a?")
+	s.Set("host/rooms/c?", &CacheEntry{})
+
+	if _, ok := s.Get("host/rooms/b?"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	if s.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", s.Len())
+	}
+
+	s.DeletePrefix("host/rooms/a?")
+	if _, ok := s.Get("host/rooms/a?"); ok {
+		t.Error("Expected entry to be deleted by prefix")
+	}
+	if _, ok := s.Get("host/rooms/c?"); !ok {
+		t.Error("Expected other entry to remain")
+	}
+}
@@ -0,0 +1,446 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrCassetteMiss is returned in replay mode for a request that matches no
+// unused interaction of the cassette.
+var ErrCassetteMiss = errors.New("no matching interaction in cassette")
+
+// CassetteMode selects whether a Cassette records or replays.
+type CassetteMode int
+
+const (
+	// CassetteReplay answers requests from the cassette file without
+	// touching the network.
+	CassetteReplay CassetteMode = iota
+
+	// CassetteRecord sends requests to the network and writes each
+	// request/response pair to the cassette file, replacing its contents.
+	CassetteRecord
+)
+
+// redacted replaces secret values in recorded interactions.
+const redacted = "REDACTED"
+
+// CassetteConfig holds the configuration for a Cassette.
+type CassetteConfig struct {
+	// Path is the cassette file.
+	Path string
+
+	// Mode selects recording or replaying. Default: CassetteReplay.
+	Mode CassetteMode
+
+	// RedactHeaders are request and response headers whose values are
+	// replaced before recording, in addition to Authorization, Cookie and
+	// Set-Cookie.
+	RedactHeaders []string
+
+	// RedactFields are JSON body fields, form fields and query parameters
+	// whose values are replaced before recording, in addition to
+	// access_token, refresh_token, id_token, client_secret, token and
+	// password.
+	RedactFields []string
+
+	// IgnoreFields are JSON body fields left out when matching requests,
+	// for values that differ between runs such as generated IDs.
+	IgnoreFields []string
+}
+
+// Cassette records HTTP interactions to a file and replays them, so that
+// tests exercise real payloads without network access. Set it as
+// Config.Cassette to route every request of a client through it,
+// including the calling and device packages' requests:
+//
+//	cassette, err := webexsdk.NewCassette(&webexsdk.CassetteConfig{Path: "testdata/rooms.json"})
+//	client, err := webexsdk.NewClient(token, &webexsdk.Config{Cassette: cassette})
+//
+// Requests are matched on method, path, query and body; the host is
+// ignored. Each recorded interaction is replayed at most once, in
+// recording order. Secrets are redacted before they are written, and
+// requests are redacted the same way before they are matched.
+type Cassette struct {
+	config       *CassetteConfig
+	redactHeader map[string]bool
+	redactField  map[string]bool
+	ignoreField  map[string]bool
+
+	mu           sync.Mutex
+	interactions []*interaction
+	used         []bool
+}
+
+// interaction is a recorded request/response pair.
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"bodyBase64,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"bodyBase64,omitempty"`
+}
+
+// cassetteFile is the on-disk format of a cassette.
+type cassetteFile struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+// NewCassette creates a cassette. In replay mode the cassette file must
+// exist.
+func NewCassette(config *CassetteConfig) (*Cassette, error) {
+	if config == nil || config.Path == "" {
+		return nil, fmt.Errorf("cassette path cannot be empty")
+	}
+
+	c := &Cassette{
+		config:       config,
+		redactHeader: make(map[string]bool),
+		redactField:  make(map[string]bool),
+		ignoreField:  make(map[string]bool),
+	}
+	for _, h := range append([]string{"Authorization", "Cookie", "Set-Cookie"}, config.RedactHeaders...) {
+		c.redactHeader[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, f := range append([]string{"access_token", "refresh_token", "id_token", "client_secret", "token", "password"}, config.RedactFields...) {
+		c.redactField[f] = true
+	}
+	for _, f := range config.IgnoreFields {
+		c.ignoreField[f] = true
+	}
+
+	if config.Mode == CassetteReplay {
+		data, err := os.ReadFile(config.Path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading cassette: %w", err)
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("error parsing cassette %s: %w", config.Path, err)
+		}
+		c.interactions = file.Interactions
+		c.used = make([]bool, len(file.Interactions))
+	}
+
+	return c, nil
+}
+
+// Middleware returns the cassette as a Middleware. Config.Cassette places
+// it innermost, so that it sees requests as they would go on the wire.
+func (c *Cassette) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if c.config.Mode == CassetteRecord {
+				return c.record(next, req)
+			}
+			return c.replay(req)
+		})
+	}
+}
+
+// Unused returns the number of recorded interactions that have not been
+// replayed, so that tests can check all expected requests were made.
+func (c *Cassette) Unused() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, used := range c.used {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+// record sends req and stores the redacted exchange.
+func (c *Cassette) record(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	rec := &interaction{
+		Request: c.redactRequest(req, reqBody),
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     c.redactHeaders(resp.Header),
+		},
+	}
+	rec.Response.Body, rec.Response.BodyBase64 = encodeBody(c.redactBody(resp.Header.Get("Content-Type"), respBody))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, rec)
+	c.used = append(c.used, true)
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replay answers req with the first unused matching interaction.
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	want := c.redactRequest(req, reqBody)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, rec := range c.interactions {
+		if c.used[i] || !c.matches(rec.Request, want) {
+			continue
+		}
+		c.used[i] = true
+
+		body, err := decodeBody(rec.Response.Body, rec.Response.BodyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding cassette response: %w", err)
+		}
+		header := rec.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			StatusCode:    rec.Response.StatusCode,
+			Status:        fmt.Sprintf("%d %s", rec.Response.StatusCode, http.StatusText(rec.Response.StatusCode)),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrCassetteMiss, req.Method, want.URL)
+}
+
+// matches reports whether a recorded request matches a live one on
+// method, path, query and body.
+func (c *Cassette) matches(rec, live recordedRequest) bool {
+	if rec.Method != live.Method {
+		return false
+	}
+	recURL, err1 := url.Parse(rec.URL)
+	liveURL, err2 := url.Parse(live.URL)
+	if err1 != nil || err2 != nil {
+		return rec.URL == live.URL
+	}
+	if recURL.Path != liveURL.Path || recURL.Query().Encode() != liveURL.Query().Encode() {
+		return false
+	}
+
+	recBody, err1 := decodeBody(rec.Body, rec.BodyBase64)
+	liveBody, err2 := decodeBody(live.Body, live.BodyBase64)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return bytes.Equal(c.matchableBody(recBody), c.matchableBody(liveBody))
+}
+
+// matchableBody returns body in canonical form for matching: JSON is
+// re-encoded without the ignored fields, other bodies are used as is.
+func (c *Cassette) matchableBody(body []byte) []byte {
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil {
+		return body
+	}
+	canonical, err := json.Marshal(walkJSON(v, func(key string, value any) (any, bool) {
+		return value, !c.ignoreField[key]
+	}))
+	if err != nil {
+		return body
+	}
+	return canonical
+}
+
+// redactRequest returns the recorded form of req with secrets replaced.
+// Multipart boundaries are normalized so that bodies can be matched.
+func (c *Cassette) redactRequest(req *http.Request, body []byte) recordedRequest {
+	u := *req.URL
+	query := u.Query()
+	for key := range query {
+		if c.redactField[key] {
+			query.Set(key, redacted)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	header := c.redactHeaders(req.Header)
+	contentType := req.Header.Get("Content-Type")
+	if _, params, err := mime.ParseMediaType(contentType); err == nil && params["boundary"] != "" {
+		body = bytes.ReplaceAll(body, []byte(params["boundary"]), []byte("BOUNDARY"))
+		header.Set("Content-Type", strings.ReplaceAll(contentType, params["boundary"], "BOUNDARY"))
+	}
+
+	rec := recordedRequest{Method: req.Method, URL: u.String(), Header: header}
+	rec.Body, rec.BodyBase64 = encodeBody(c.redactBody(contentType, body))
+	return rec
+}
+
+// redactHeaders returns a copy of h with secret header values replaced.
+func (c *Cassette) redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for key := range out {
+		if c.redactHeader[http.CanonicalHeaderKey(key)] {
+			out[key] = []string{redacted}
+		}
+	}
+	return out
+}
+
+// redactBody replaces secret fields of a JSON or form-encoded body.
+func (c *Cassette) redactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		for key := range form {
+			if c.redactField[key] {
+				form.Set(key, redacted)
+			}
+		}
+		return []byte(form.Encode())
+	}
+
+	var v any
+	if json.Unmarshal(body, &v) != nil {
+		return body
+	}
+	changed := false
+	v = walkJSON(v, func(key string, value any) (any, bool) {
+		if c.redactField[key] {
+			changed = true
+			return redacted, true
+		}
+		return value, true
+	})
+	if !changed {
+		return body
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// save writes the cassette file. The caller must hold c.mu.
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.config.Path), 0o755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(c.config.Path, data, 0o644); err != nil {
+		return fmt.Errorf("error writing cassette: %w", err)
+	}
+	return nil
+}
+
+// walkJSON rebuilds a decoded JSON value, passing each object member to
+// fn, which returns the member's new value and whether to keep it.
+func walkJSON(v any, fn func(key string, value any) (any, bool)) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			if value, keep := fn(key, walkJSON(value, fn)); keep {
+				out[key] = value
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, value := range v {
+			out[i] = walkJSON(value, fn)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// readRequestBody reads req's body and replaces it so it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return body, nil
+}
+
+// encodeBody returns body as text, or as base64 if it is not valid UTF-8.
+func encodeBody(body []byte) (text, b64 string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return "", base64.StdEncoding.EncodeToString(body)
+}
+
+// decodeBody reverses encodeBody.
+func decodeBody(text, b64 string) ([]byte, error) {
+	if b64 != "" {
+		return base64.StdEncoding.DecodeString(b64)
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,158 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// exerciseCassette makes the same requests against client in record and
+// replay mode and returns the response bodies.
+func exerciseCassette(t *testing.T, client *Client) []string {
+	t.Helper()
+	ctx := context.Background()
+	var bodies []string
+
+	read := func(resp *http.Response, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		bodies = append(bodies, string(data))
+	}
+
+	read(client.RequestWithRetry(ctx, http.MethodGet, "rooms", url.Values{"max": {"1"}, "type": {"group"}}, nil))
+	read(client.RequestURLWithRetry(ctx, http.MethodGet, client.BaseURL.String()+"/rooms?max=1&cursor=abc", nil))
+	read(client.RequestWithRetry(ctx, http.MethodPost, "messages", nil, map[string]string{"roomId": "r1", "text": "hi"}))
+	read(client.RequestMultipartWithRetry(ctx, "messages",
+		[]MultipartField{{Name: "roomId", Value: "r1"}},
+		[]MultipartFile{{FieldName: "files", FileName: "a.txt", Content: []byte("hello")}}))
+
+	req, _ := http.NewRequest(http.MethodPost, client.BaseURL.String()+"/access_token",
+		strings.NewReader("grant_type=refresh_token&refresh_token=live-refresh&client_secret=live-secret"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	read(client.Do(req))
+
+	return bodies
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/access_token":
+			_, _ = w.Write([]byte(`{"access_token":"live-access","expires_in":3600}`))
+		case r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/"):
+			_, _ = w.Write([]byte(`{"id":"m-file","files":["a.txt"]}`))
+		case r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":"m1","echo":` + string(body) + `}`))
+		default:
+			w.Header().Set("Link", `<`+"http://"+r.Host+`/rooms?cursor=abc>; rel="next"`)
+			_, _ = w.Write([]byte(`{"items":[{"id":"room-` + r.URL.Query().Get("cursor") + `"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "rooms.json")
+
+	recorder, err := NewCassette(&CassetteConfig{Path: path, Mode: CassetteRecord})
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %v", err)
+	}
+	client, _ := NewClient("live-token", &Config{BaseURL: server.URL, HttpClient: server.Client(), Cassette: recorder})
+	recorded := exerciseCassette(t, client)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected cassette file: %v", err)
+	}
+	for _, secret := range []string{"live-token", "live-refresh", "live-secret", "live-access"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %q to be redacted from cassette", secret)
+		}
+	}
+	if !strings.Contains(string(data), redacted) {
+		t.Error("Expected redacted values in cassette")
+	}
+
+	// Replay against a dead server with a different token
+	server.Close()
+	player, err := NewCassette(&CassetteConfig{Path: path})
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+	client, _ = NewClient("other-token", &Config{BaseURL: server.URL, Cassette: player})
+	replayed := exerciseCassette(t, client)
+
+	for i := range recorded {
+		if i == len(recorded)-1 {
+			// Token response body was redacted on record
+			if !strings.Contains(replayed[i], redacted) {
+				t.Errorf("Expected redacted token response, got %s", replayed[i])
+			}
+			continue
+		}
+		if recorded[i] != replayed[i] {
+			t.Errorf("Response %d differs: recorded %s, replayed %s", i, recorded[i], replayed[i])
+		}
+	}
+	if player.Unused() != 0 {
+		t.Errorf("Expected all interactions replayed, %d unused", player.Unused())
+	}
+
+	// Interactions are replayed once; a different body does not match
+	_, err = client.RequestWithRetry(context.Background(), http.MethodPost, "messages", nil, map[string]string{"roomId": "r2"})
+	if !errors.Is(err, ErrCassetteMiss) {
+		t.Errorf("Expected ErrCassetteMiss, got %v", err)
+	}
+}
+
+func TestCassette_IgnoreFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ignore.json")
+	cassette := `{"interactions":[{"request":{"method":"POST","url":"https://mobius/api/v1/calling/web/device","body":"{\"correlationId\":\"abc\",\"userId\":\"u1\"}"},"response":{"statusCode":200,"body":"{\"ok\":true}"}}]}`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := NewCassette(&CassetteConfig{Path: path, IgnoreFields: []string{"correlationId"}})
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+	client, _ := NewClient("token", &Config{Cassette: player})
+
+	req, _ := http.NewRequest(http.MethodPost, "https://mobius.example/api/v1/calling/web/device",
+		strings.NewReader(`{"userId":"u1","correlationId":"xyz"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Expected match ignoring correlationId, got %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestNewCassette_MissingFile(t *testing.T) {
+	if _, err := NewCassette(&CassetteConfig{Path: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Error("Expected error for missing cassette in replay mode")
+	}
+	if _, err := NewCassette(nil); err == nil {
+		t.Error("Expected error for nil config")
+	}
+}
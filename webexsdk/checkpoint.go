@@ -0,0 +1,153 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+	"time"
+)
+
+// Checkpoint records how far a poller has delivered the items of a
+// time-windowed listing, such as admin audit or compliance events. Pollers
+// query each window again from Overlap before Until, to pick up items that
+// became visible late, and skip the items recorded in Seen. It is
+// JSON-encodable so that a CheckpointStore can persist it.
+type Checkpoint struct {
+	// Until is the end of the last window whose items were all delivered.
+	Until time.Time `json:"until"`
+
+	// Seen holds the IDs and creation times of delivered items that a
+	// later window may return again.
+	Seen map[string]time.Time `json:"seen,omitempty"`
+
+	// LastEventID and LastEventTime identify the newest delivered item.
+	LastEventID   string    `json:"lastEventId,omitempty"`
+	LastEventTime time.Time `json:"lastEventTime,omitempty"`
+}
+
+// HasSeen reports whether the item id was delivered.
+func (cp *Checkpoint) HasSeen(id string) bool {
+	_, ok := cp.Seen[id]
+	return ok
+}
+
+// MarkSeen records that the item id, created at created, was delivered.
+func (cp *Checkpoint) MarkSeen(id string, created time.Time) {
+	if cp.Seen == nil {
+		cp.Seen = make(map[string]time.Time)
+	}
+	cp.Seen[id] = created
+	if !created.Before(cp.LastEventTime) {
+		cp.LastEventID, cp.LastEventTime = id, created
+	}
+}
+
+// Advance moves Until to until and forgets the seen items created before
+// until-overlap, which no later window returns.
+func (cp *Checkpoint) Advance(until time.Time, overlap time.Duration) {
+	cp.Until = until
+	horizon := until.Add(-overlap)
+	for id, created := range cp.Seen {
+		if created.Before(horizon) {
+			delete(cp.Seen, id)
+		}
+	}
+}
+
+// Clone returns a copy of the checkpoint.
+func (cp *Checkpoint) Clone() *Checkpoint {
+	clone := *cp
+	clone.Seen = maps.Clone(cp.Seen)
+	return &clone
+}
+
+// CheckpointStore persists the Checkpoint of a poller so that it resumes
+// where it left off after a restart.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*Checkpoint, error)
+
+	// Save replaces the saved checkpoint.
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps the checkpoint in
+// memory. It does not survive restarts.
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{}
+}
+
+// Load implements CheckpointStore.
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+	return s.checkpoint.Clone(), nil
+}
+
+// Save implements CheckpointStore.
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = checkpoint.Clone()
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoint as
+// JSON in a file. Saves replace the file atomically.
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore for path. The file
+// is created on the first Save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load implements CheckpointStore.
+func (s *FileCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %s: %w", s.path, err)
+	}
+	return &checkpoint, nil
+}
+
+// Save implements CheckpointStore.
+func (s *FileCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
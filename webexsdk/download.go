@@ -0,0 +1,313 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxResumes is the number of times a download is resumed after
+// its body is interrupted, unless DownloadConfig.MaxResumes is set.
+const DefaultMaxResumes = 3
+
+// DownloadConfig configures Client.Download and Client.DownloadToFile.
+type DownloadConfig struct {
+	// MaxResumes is the number of times the download is resumed with a
+	// Range request after the connection drops mid-body. Zero means
+	// DefaultMaxResumes; a negative value disables resuming.
+	MaxResumes int
+}
+
+// DownloadResult describes a completed streamed download.
+type DownloadResult struct {
+	// ContentType is the MIME type of the content.
+	ContentType string
+
+	// ContentDisposition is the Content-Disposition header value, if any.
+	ContentDisposition string
+
+	// FileName is the filename from Content-Disposition, reduced to its
+	// base name, or "" if the server sent none.
+	FileName string
+
+	// Size is the number of bytes written.
+	Size int64
+
+	// Resumes is the number of times the download was resumed.
+	Resumes int
+
+	// Path is the file written by DownloadToFile.
+	Path string
+}
+
+// truncater is implemented by writers such as *os.File that can be reset
+// when a server ignores a Range request.
+type truncater interface {
+	io.Seeker
+	Truncate(size int64) error
+}
+
+// Download streams the content at rawURL to w. The request is
+// authenticated and retried like RequestURLWithRetry, so 423 (file being
+// scanned), 429 and 5xx responses are retried according to Config. If
+// the connection drops while the body is being read, the download is
+// resumed from the last byte written with an HTTP Range request (and
+// If-Range, so that a changed file is not spliced). The number of bytes
+// written is checked against the Content-Length.
+//
+// Error responses are returned as structured errors (see NewAPIError).
+func (c *Client) Download(ctx context.Context, rawURL string, w io.Writer, config *DownloadConfig) (*DownloadResult, error) {
+	return c.download(ctx, rawURL, config, func(*DownloadResult) (io.Writer, error) { return w, nil })
+}
+
+// DownloadToFile streams the content at rawURL to a file like Download.
+// If path is an existing directory, the file is created in it with the
+// filename from the Content-Disposition header (or fallbackName if there
+// is none), never replacing an existing file: if the name is taken, a
+// number is added to it, as in "report (1).pdf". Otherwise the file is
+// created at path. The file is removed if the download fails.
+func (c *Client) DownloadToFile(ctx context.Context, rawURL, path, fallbackName string, config *DownloadConfig) (*DownloadResult, error) {
+	var file *os.File
+	result, err := c.download(ctx, rawURL, config, func(result *DownloadResult) (io.Writer, error) {
+		var f *os.File
+		var err error
+		if info, statErr := os.Stat(path); statErr == nil && info.IsDir() {
+			name := result.FileName
+			if name == "" && fallbackName != "" {
+				name = filepath.Base(fallbackName)
+			}
+			if name == "" {
+				return nil, fmt.Errorf("no filename for download into directory %s", path)
+			}
+			f, err = createUnique(path, name)
+		} else {
+			f, err = os.Create(path)
+		}
+		if err != nil {
+			return nil, err
+		}
+		file = f
+		result.Path = f.Name()
+		return f, nil
+	})
+	if file == nil {
+		return result, err
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return nil, err
+	}
+	return result, nil
+}
+
+// maxUniqueNames is how many numbered names createUnique tries.
+const maxUniqueNames = 1000
+
+// createUnique creates a new file named name in dir, or "name (n).ext" if
+// that exists. Files are opened with O_EXCL, so a file created
+// concurrently is not replaced either.
+func createUnique(dir, name string) (*os.File, error) {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for n := 0; n < maxUniqueNames; n++ {
+		candidate := name
+		if n > 0 {
+			candidate = fmt.Sprintf("%s (%d)%s", base, n, ext)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, candidate), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o666)
+		if !errors.Is(err, fs.ErrExist) {
+			return f, err
+		}
+	}
+	return nil, fmt.Errorf("no free filename for %s in directory %s", name, dir)
+}
+
+// download implements Download, calling open with the response metadata
+// to get the writer once the first response has been received.
+func (c *Client) download(ctx context.Context, rawURL string, config *DownloadConfig, open func(*DownloadResult) (io.Writer, error)) (*DownloadResult, error) {
+	maxResumes := DefaultMaxResumes
+	if config != nil && config.MaxResumes != 0 {
+		maxResumes = max(config.MaxResumes, 0)
+	}
+
+	var result *DownloadResult
+	var w io.Writer
+	var validator string // ETag or Last-Modified of the first response
+	total := int64(-1)
+
+	for {
+		offset := int64(0)
+		if result != nil {
+			offset = result.Size
+		}
+
+		resp, err := c.doWithRetry(ctx, http.MethodGet, rawURL, func(ctx context.Context) (*http.Response, error) {
+			return c.doRangeRequest(ctx, rawURL, offset, validator)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 400 {
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			return nil, NewAPIError(resp, body)
+		}
+
+		switch {
+		case result == nil:
+			result = &DownloadResult{
+				ContentType:        resp.Header.Get("Content-Type"),
+				ContentDisposition: resp.Header.Get("Content-Disposition"),
+				FileName:           ContentDispositionFileName(resp.Header.Get("Content-Disposition")),
+			}
+			total = resp.ContentLength
+			if validator = resp.Header.Get("ETag"); validator == "" || strings.HasPrefix(validator, "W/") {
+				validator = resp.Header.Get("Last-Modified")
+			}
+			if w, err = open(result); err != nil {
+				_ = resp.Body.Close()
+				return nil, err
+			}
+		case resp.StatusCode == http.StatusPartialContent:
+			if start, ok := contentRangeStart(resp.Header.Get("Content-Range")); !ok || start != offset {
+				_ = resp.Body.Close()
+				return nil, fmt.Errorf("error resuming download: unexpected Content-Range %q", resp.Header.Get("Content-Range"))
+			}
+		case offset == 0:
+			total = resp.ContentLength
+		default:
+			// The server ignored the Range or the content changed: start over
+			t, ok := w.(truncater)
+			if !ok {
+				_ = resp.Body.Close()
+				return nil, fmt.Errorf("error resuming download: server returned %d instead of partial content", resp.StatusCode)
+			}
+			if _, err := t.Seek(0, io.SeekStart); err == nil {
+				err = t.Truncate(0)
+			}
+			if err != nil {
+				_ = resp.Body.Close()
+				return nil, fmt.Errorf("error restarting download: %w", err)
+			}
+			result.Size = 0
+			total = resp.ContentLength
+		}
+
+		body := &readErrorTracker{r: resp.Body}
+		n, err := io.Copy(w, body)
+		_ = resp.Body.Close()
+		result.Size += n
+
+		if err != nil && body.err == nil {
+			return nil, fmt.Errorf("error writing download: %w", err)
+		}
+		if err == nil && (total < 0 || result.Size >= total) {
+			break
+		}
+
+		// The body ended early; resume unless the context is done
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if result.Resumes >= maxResumes {
+			if err == nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, fmt.Errorf("error reading download after %d bytes: %w", result.Size, err)
+		}
+		result.Resumes++
+		c.slog.DebugContext(ctx, "resuming webex download", "offset", result.Size, "resumes", result.Resumes, "error", err)
+	}
+
+	if total >= 0 && result.Size != total {
+		return nil, fmt.Errorf("download length mismatch: got %d bytes, expected %d", result.Size, total)
+	}
+	return result, nil
+}
+
+// doRangeRequest performs a single GET of rawURL, from offset if it is
+// not zero.
+func (c *Client) doRangeRequest(ctx context.Context, rawURL string, offset int64, validator string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setAuthorization(req); err != nil {
+		return nil, err
+	}
+	for k, v := range c.Config.DefaultHeaders {
+		req.Header.Set(k, v)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if validator != "" {
+			req.Header.Set("If-Range", validator)
+		}
+	}
+	return c.send(req)
+}
+
+// ContentDispositionFileName returns the filename parameter of a
+// Content-Disposition header, reduced to its base name so that it is safe
+// to use as a path element, or "" if there is none.
+func ContentDispositionFileName(header string) string {
+	if header == "" {
+		return ""
+	}
+	_, params, err := mime.ParseMediaType(header)
+	if err != nil {
+		return ""
+	}
+	name := filepath.Base(filepath.Clean("/" + strings.ReplaceAll(params["filename"], `\`, "/")))
+	if name == "/" || name == "." {
+		return ""
+	}
+	return name
+}
+
+// contentRangeStart parses the first byte position of a Content-Range
+// header such as "bytes 100-199/200".
+func contentRangeStart(header string) (int64, bool) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	start, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	return n, err == nil
+}
+
+// readErrorTracker records the error returned by r, to tell read errors
+// apart from write errors in io.Copy.
+type readErrorTracker struct {
+	r   io.Reader
+	err error
+}
+
+func (t *readErrorTracker) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		t.err = err
+	}
+	return n, err
+}
@@ -0,0 +1,99 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestContentDispositionFileName(t *testing.T) {
+	for header, want := range map[string]string{
+		`attachment; filename="report.pdf"`:                 "report.pdf",
+		`attachment; filename*=UTF-8''r%C3%A9sum%C3%A9.pdf`: "résumé.pdf",
+		`attachment; filename="../../etc/passwd"`:           "passwd",
+		`attachment; filename="C:\temp\notes.txt"`:          "notes.txt",
+		`attachment; filename=".."`:                         "",
+		`attachment`:                                        "",
+		``:                                                  "",
+		`;;invalid`:                                         "",
+	} {
+		if got := ContentDispositionFileName(header); got != want {
+			t.Errorf("ContentDispositionFileName(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestDownloadToFile_RestartsWhenRangeIgnored(t *testing.T) {
+	data := []byte(strings.Repeat("abcdefghij", 500))
+	requests := 0
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if requests == 1 {
+			_, _ = w.Write(data[:1000])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		// Ignore the Range header and send the whole file again
+		_, _ = w.Write(data)
+	}, &Config{})
+
+	path := filepath.Join(t.TempDir(), "file.bin")
+	result, err := client.DownloadToFile(context.Background(), client.BaseURL.String()+"/contents/x", path, "", nil)
+	if err != nil {
+		t.Fatalf("DownloadToFile failed: %v", err)
+	}
+	if result.Resumes != 1 || result.Size != int64(len(data)) {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, data) {
+		t.Errorf("File content mismatch: %d bytes", len(got))
+	}
+
+	// A plain writer cannot be rewound, so the download fails
+	requests = 0
+	if _, err := client.Download(context.Background(), client.BaseURL.String()+"/contents/x", &bytes.Buffer{}, nil); err == nil {
+		t.Error("Expected error when the server ignores Range for a non-seekable writer")
+	}
+}
+
+func TestDownloadToFile_DirectoryKeepsExistingFiles(t *testing.T) {
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Disposition", `attachment; filename="report.pdf"`)
+		_, _ = w.Write([]byte("new"))
+	}, &Config{})
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "report.pdf")
+	if err := os.WriteFile(existing, []byte("old"), 0o600); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	for _, want := range []string{"report (1).pdf", "report (2).pdf"} {
+		result, err := client.DownloadToFile(context.Background(), client.BaseURL.String()+"/contents/x", dir, "", nil)
+		if err != nil {
+			t.Fatalf("DownloadToFile failed: %v", err)
+		}
+		if result.Path != filepath.Join(dir, want) {
+			t.Errorf("Expected %s, got %s", want, result.Path)
+		}
+		if got, _ := os.ReadFile(result.Path); string(got) != "new" {
+			t.Errorf("Unexpected content of %s: %q", result.Path, got)
+		}
+	}
+	if got, _ := os.ReadFile(existing); string(got) != "old" {
+		t.Errorf("Existing file was overwritten: %q", got)
+	}
+}
@@ -0,0 +1,176 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Environment is a Webex deployment: the endpoints every subsystem starts
+// from and the hosts requests may go to. Set it with Config.Environment to
+// configure the REST client, device, mercury, calling and encryption
+// packages consistently and to reject requests to other hosts.
+//
+// Commercial and FedRAMP are predefined; a custom Environment can be
+// declared for private deployments or tests.
+type Environment struct {
+	// Name identifies the environment in errors, e.g. "commercial".
+	Name string
+
+	// APIBaseURL is the base URL of the Webex REST API.
+	APIBaseURL string
+
+	// WDMURL is the Webex Device Management URL used to register devices
+	// for Mercury and Calling.
+	WDMURL string
+
+	// MercuryURL is the Mercury WebSocket URL used when device
+	// registration does not supply one.
+	MercuryURL string
+
+	// RegionDiscoveryURL is the region discovery service used by Calling.
+	RegionDiscoveryURL string
+
+	// KMSURL is the URL of the KMS API of the encryption service, with
+	// "{cluster}" standing for the KMS cluster.
+	KMSURL string
+
+	// KMSCluster is the default KMS cluster.
+	KMSCluster string
+
+	// MobiusHost is the Mobius host Calling queries when device
+	// registration supplies none. If empty, Calling requires the hosts
+	// from device registration.
+	MobiusHost string
+
+	// AllowedHosts lists the hosts requests may go to. An entry of the
+	// form "*.example.com" matches every subdomain of example.com. If
+	// empty, the hosts of the URLs above are allowed.
+	AllowedHosts []string
+}
+
+// Commercial is the commercial Webex environment, which the SDK uses by
+// default.
+var Commercial = &Environment{
+	Name:               "commercial",
+	APIBaseURL:         "https://webexapis.com/v1",
+	WDMURL:             "https://wdm-a.wbx2.com/wdm/api/v1/devices",
+	MercuryURL:         "wss://mercury-connection-a.wbx2.com/mercury/device",
+	RegionDiscoveryURL: "https://ds.ciscospark.com/v1/region",
+	KMSURL:             "https://encryption-{cluster}.wbx2.com/encryption/api/v1/kms",
+	KMSCluster:         "a",
+	MobiusHost:         "mobius-eu-central-1.prod.infra.webex.com",
+	AllowedHosts: []string{
+		"webexapis.com",
+		"*.webexapis.com",
+		"*.wbx2.com",
+		"*.ciscospark.com",
+		"*.webex.com",
+		"*.webexcontent.com",
+	},
+}
+
+// FedRAMP is Webex for Government, the FedRAMP-authorized environment.
+// Device registration supplies the Mercury and Mobius hosts at runtime;
+// the others are fixed here. AllowedHosts excludes every commercial host.
+var FedRAMP = &Environment{
+	Name:               "fedramp",
+	APIBaseURL:         "https://api-usgov.webex.com/v1",
+	WDMURL:             "https://wdm.a1.us.webex.com/wdm/api/v1/devices",
+	MercuryURL:         "wss://mercury-connection.a1.us.webex.com/mercury/device",
+	RegionDiscoveryURL: "https://ds.gov.ciscospark.com/v1/region",
+	KMSURL:             "https://encryption.a1.us.webex.com/encryption/api/v1/kms",
+	KMSCluster:         "a1",
+	AllowedHosts: []string{
+		"api-usgov.webex.com",
+		"*.us.webex.com",
+		"*.gov.ciscospark.com",
+	},
+}
+
+// KMSURLFor returns the KMS API URL for cluster, or for KMSCluster if
+// cluster is empty.
+func (e *Environment) KMSURLFor(cluster string) string {
+	if cluster == "" {
+		cluster = e.KMSCluster
+	}
+	return strings.ReplaceAll(e.KMSURL, "{cluster}", cluster)
+}
+
+// Allows reports whether requests may be sent to host (a hostname,
+// optionally with a port).
+func (e *Environment) Allows(host string) bool {
+	host = strings.ToLower(hostname(host))
+	patterns := e.AllowedHosts
+	if len(patterns) == 0 {
+		patterns = e.endpointHosts()
+	}
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+			if strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckURL returns a *HostNotAllowedError if rawURL's host is not allowed.
+func (e *Environment) CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if !e.Allows(u.Host) {
+		return &HostNotAllowedError{Host: u.Hostname(), Environment: e.Name}
+	}
+	return nil
+}
+
+// endpointHosts returns the hosts of the environment's URLs.
+func (e *Environment) endpointHosts() []string {
+	var hosts []string
+	for _, raw := range []string{e.APIBaseURL, e.WDMURL, e.MercuryURL, e.RegionDiscoveryURL, e.KMSURLFor("")} {
+		if u, err := url.Parse(raw); err == nil && u.Host != "" {
+			hosts = append(hosts, u.Hostname())
+		}
+	}
+	if e.MobiusHost != "" {
+		hosts = append(hosts, e.MobiusHost)
+	}
+	return hosts
+}
+
+// hostname strips the port from host.
+func hostname(host string) string {
+	return (&url.URL{Host: host}).Hostname()
+}
+
+// Environment returns the client's Environment, or Commercial if none is
+// configured. Packages use it for the endpoints they start from.
+func (c *Client) Environment() *Environment {
+	if c.Config.Environment != nil {
+		return c.Config.Environment
+	}
+	return Commercial
+}
+
+// CheckURL returns a *HostNotAllowedError if Config.Environment is set and
+// rawURL's host is not one of its allowed hosts. Requests sent by the
+// client are checked automatically; packages that open connections
+// themselves, such as Mercury's WebSocket and the encryption service,
+// call it first.
+func (c *Client) CheckURL(rawURL string) error {
+	if c.Config.Environment == nil {
+		return nil
+	}
+	return c.Config.Environment.CheckURL(rawURL)
+}
@@ -0,0 +1,98 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestEnvironment_Allows(t *testing.T) {
+	tests := []struct {
+		env  *Environment
+		host string
+		want bool
+	}{
+		{Commercial, "webexapis.com", true},
+		{Commercial, "webexapis.com:443", true},
+		{Commercial, "wdm-a.wbx2.com", true},
+		{Commercial, "WBX2.COM", false},
+		{Commercial, "api-usgov.webex.com", true},
+		{Commercial, "example.com", false},
+		{FedRAMP, "api-usgov.webex.com", true},
+		{FedRAMP, "mercury-connection.a1.us.webex.com", true},
+		{FedRAMP, "webexapis.com", false},
+		{FedRAMP, "wdm-a.wbx2.com", false},
+		{&Environment{APIBaseURL: "http://127.0.0.1:8080/v1"}, "127.0.0.1:9090", true},
+		{&Environment{APIBaseURL: "http://127.0.0.1:8080/v1"}, "localhost", false},
+	}
+	for _, tt := range tests {
+		if got := tt.env.Allows(tt.host); got != tt.want {
+			t.Errorf("%s.Allows(%q) = %v, want %v", tt.env.Name, tt.host, got, tt.want)
+		}
+	}
+
+	if got := Commercial.KMSURLFor(""); got != "https://encryption-a.wbx2.com/encryption/api/v1/kms" {
+		t.Errorf("Unexpected KMS URL %q", got)
+	}
+}
+
+func TestNewClient_Environment(t *testing.T) {
+	client, err := NewClient("token", &Config{Environment: FedRAMP})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if got := client.BaseURL.String(); got != FedRAMP.APIBaseURL {
+		t.Errorf("Expected BaseURL %q, got %q", FedRAMP.APIBaseURL, got)
+	}
+	if client.Environment() != FedRAMP {
+		t.Error("Expected the FedRAMP environment")
+	}
+
+	_, err = NewClient("token", &Config{Environment: FedRAMP, BaseURL: Commercial.APIBaseURL})
+	if !IsHostNotAllowed(err) {
+		t.Errorf("Expected host not allowed error, got %v", err)
+	}
+
+	client, _ = NewClient("token", nil)
+	if client.Environment() != Commercial {
+		t.Error("Expected the Commercial environment by default")
+	}
+	if err := client.CheckURL("https://example.com"); err != nil {
+		t.Errorf("Expected no host check without an Environment, got %v", err)
+	}
+}
+
+func TestSend_RejectsHostOutsideEnvironment(t *testing.T) {
+	requests := 0
+	env := &Environment{Name: "test", AllowedHosts: []string{"127.0.0.1"}}
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}, &Config{Environment: env})
+
+	resp, err := client.RequestWithRetry(context.Background(), http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// e.g. a pagination link or download URL pointing elsewhere
+	_, err = client.RequestURLWithRetry(context.Background(), http.MethodGet, "https://webexapis.com/v1/rooms", nil)
+	var hostErr *HostNotAllowedError
+	if !errors.As(err, &hostErr) {
+		t.Fatalf("Expected *HostNotAllowedError, got %v", err)
+	}
+	if hostErr.Host != "webexapis.com" || hostErr.Environment != "test" {
+		t.Errorf("Unexpected error fields: %+v", hostErr)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the rejected request not to be sent, got %d requests", requests)
+	}
+}
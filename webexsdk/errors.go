@@ -11,7 +11,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 )
 
@@ -141,6 +140,59 @@ type ServerError struct {
 // Unwrap returns the underlying APIError for errors.As traversal.
 func (e *ServerError) Unwrap() error { return e.APIError }
 
+// ErrCircuitOpen is returned, wrapped in a *CircuitOpenError, for requests
+// that a CircuitBreaker rejected without sending them.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned for requests rejected by an open or
+// half-open circuit. It unwraps to ErrCircuitOpen.
+type CircuitOpenError struct {
+	// Key identifies the circuit, e.g. "webexapis.com/messages".
+	Key string
+
+	// State is the state of the circuit that rejected the request.
+	State CircuitState
+
+	// RetryAfter is how long until the circuit lets trial requests
+	// through. Zero if the circuit is half-open.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *CircuitOpenError) Error() string {
+	msg := "circuit breaker is " + e.State.String() + " for " + e.Key
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(" (retry after %v)", e.RetryAfter.Round(time.Millisecond))
+	}
+	return msg
+}
+
+// Unwrap returns ErrCircuitOpen.
+func (e *CircuitOpenError) Unwrap() error { return ErrCircuitOpen }
+
+// ErrHostNotAllowed is returned, wrapped in a *HostNotAllowedError, for
+// requests to a host outside the configured Environment.
+var ErrHostNotAllowed = errors.New("host not allowed in environment")
+
+// HostNotAllowedError is returned for requests to a host outside the
+// configured Environment. The request is not sent. It unwraps to
+// ErrHostNotAllowed.
+type HostNotAllowedError struct {
+	// Host is the rejected host.
+	Host string
+
+	// Environment is the name of the configured Environment.
+	Environment string
+}
+
+// Error implements the error interface.
+func (e *HostNotAllowedError) Error() string {
+	return fmt.Sprintf("host %s is not allowed in the %s environment", e.Host, e.Environment)
+}
+
+// Unwrap returns ErrHostNotAllowed.
+func (e *HostNotAllowedError) Unwrap() error { return ErrHostNotAllowed }
+
 // --- Factory ---
 
 // apiErrorBody is used to parse the Webex API error response JSON.
@@ -150,7 +202,8 @@ type apiErrorBody struct {
 }
 
 // NewAPIError creates a structured error from an HTTP response and its body.
-// It parses the JSON body for message and trackingId fields, reads the
+// It parses the JSON body for message and trackingId fields, falling back
+// to the TrackingID header of the response or its request, reads the
 // Retry-After header, and returns the appropriate error sub-type based
 // on the HTTP status code.
 func NewAPIError(resp *http.Response, body []byte) error {
@@ -169,12 +222,13 @@ func NewAPIError(resp *http.Response, body []byte) error {
 		}
 		// If JSON parsing fails, leave Message empty — RawBody preserves the original
 	}
+	if base.TrackingID == "" {
+		base.TrackingID = responseTrackingID(resp)
+	}
 
 	// Parse Retry-After header
-	if ra := resp.Header.Get("Retry-After"); ra != "" {
-		if seconds, err := strconv.Atoi(ra); err == nil && seconds > 0 {
-			base.RetryAfter = time.Duration(seconds) * time.Second
-		}
+	if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		base.RetryAfter = ra
 	}
 
 	// Return the appropriate sub-type
@@ -261,6 +315,18 @@ func IsServerError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// IsCircuitOpen reports whether err was returned because a CircuitBreaker
+// rejected the request.
+func IsCircuitOpen(err error) bool {
+	return errors.Is(err, ErrCircuitOpen)
+}
+
+// IsHostNotAllowed reports whether err was returned because a request
+// would have gone to a host outside the configured Environment.
+func IsHostNotAllowed(err error) bool {
+	return errors.Is(err, ErrHostNotAllowed)
+}
+
 // FieldError represents an error on a specific field of a resource.
 // When the Webex API encounters a partial failure retrieving a resource
 // in a list response, individual fields may contain errors instead of
@@ -0,0 +1,191 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+// Package ids converts between the UUIDs used by Webex's internal services,
+// such as Mercury activities and KMS, and the REST API's Hydra IDs. A Hydra
+// ID is the base64 encoding of a URI of the form
+// "ciscospark://<cluster>/<KIND>/<uuid>", e.g.
+// "ciscospark://us/ROOM/bbceb1ad-43f1-3b58-9147-f14bb0c4d154".
+package ids
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidID is returned (wrapped) for strings that are not Hydra IDs, or
+// not of the expected Kind.
+var ErrInvalidID = errors.New("invalid Webex ID")
+
+// Kind is the resource type segment of a Hydra ID.
+type Kind string
+
+// Kinds of Hydra IDs
+const (
+	KindPeople           Kind = "PEOPLE"
+	KindRoom             Kind = "ROOM"
+	KindMessage          Kind = "MESSAGE"
+	KindTeam             Kind = "TEAM"
+	KindMembership       Kind = "MEMBERSHIP"
+	KindTeamMembership   Kind = "TEAM_MEMBERSHIP"
+	KindAttachmentAction Kind = "ATTACHMENT_ACTION"
+	KindOrganization     Kind = "ORGANIZATION"
+)
+
+// Cluster is the cluster segment of a Hydra ID: the data residency of the
+// resource.
+type Cluster string
+
+// Clusters of Hydra IDs
+const (
+	// ClusterUS is the default cluster, used by most organizations.
+	ClusterUS Cluster = "us"
+
+	// ClusterEU is the cluster of organizations whose data resides in the
+	// EU (Frankfurt).
+	ClusterEU Cluster = "urn:TEAM:eu-central-1_k"
+)
+
+// scheme is the prefix of every decoded Hydra ID.
+const scheme = "ciscospark://"
+
+// ID is a decoded Hydra ID.
+type ID struct {
+	Cluster Cluster
+	Kind    Kind
+
+	// UUID is the ID used by the internal services. It is a UUID for every
+	// Kind above, but some resources (e.g. licenses) use other forms.
+	UUID string
+}
+
+// New returns the ID of kind for uuid in ClusterUS.
+func New(kind Kind, uuid string) ID {
+	return ID{Cluster: ClusterUS, Kind: kind, UUID: uuid}
+}
+
+// URI returns the decoded form of the ID, e.g.
+// "ciscospark://us/PEOPLE/<uuid>".
+func (id ID) URI() string {
+	return scheme + string(id.Cluster) + "/" + string(id.Kind) + "/" + id.UUID
+}
+
+// String returns the Hydra ID as used by the REST API.
+func (id ID) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id.URI()))
+}
+
+// Parse decodes a Hydra ID. Padded and unpadded, standard and URL-safe
+// base64 are accepted.
+func Parse(s string) (ID, error) {
+	uri, ok := decodeBase64(s)
+	if !ok || !strings.HasPrefix(uri, scheme) {
+		return ID{}, fmt.Errorf("%w: %q is not a Hydra ID", ErrInvalidID, s)
+	}
+	rest := strings.TrimPrefix(uri, scheme)
+
+	// The cluster may contain colons but not slashes
+	uuidAt := strings.LastIndex(rest, "/")
+	if uuidAt <= 0 {
+		return ID{}, fmt.Errorf("%w: malformed Hydra ID %q", ErrInvalidID, uri)
+	}
+	kindAt := strings.LastIndex(rest[:uuidAt], "/")
+	id := ID{
+		Cluster: Cluster(rest[:max(kindAt, 0)]),
+		Kind:    Kind(rest[kindAt+1 : uuidAt]),
+		UUID:    rest[uuidAt+1:],
+	}
+	if kindAt <= 0 || id.Kind == "" || id.UUID == "" {
+		return ID{}, fmt.Errorf("%w: malformed Hydra ID %q", ErrInvalidID, uri)
+	}
+	return id, nil
+}
+
+// Validate reports whether s is a Hydra ID of kind.
+func Validate(s string, kind Kind) error {
+	id, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	if id.Kind != kind {
+		return fmt.Errorf("%w: expected a %s ID, got a %s ID", ErrInvalidID, kind, id.Kind)
+	}
+	return nil
+}
+
+// Encode returns the Hydra ID of kind for uuid in cluster. uuid must be a
+// UUID.
+func Encode(cluster Cluster, kind Kind, uuid string) (string, error) {
+	if !IsUUID(uuid) {
+		return "", fmt.Errorf("%w: %q is not a UUID", ErrInvalidID, uuid)
+	}
+	return ID{Cluster: cluster, Kind: kind, UUID: uuid}.String(), nil
+}
+
+// FromUUID converts s, a UUID as found in Mercury activities, to the
+// Hydra ID of kind in cluster. A Hydra ID of kind is returned unchanged, so
+// FromUUID can be applied to values of either form.
+func FromUUID(cluster Cluster, kind Kind, s string) (string, error) {
+	if IsUUID(s) {
+		return Encode(cluster, kind, s)
+	}
+	if err := Validate(s, kind); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// ToUUID converts s, a Hydra ID of any kind, to the UUID used by the
+// internal services. A UUID is returned unchanged.
+func ToUUID(s string) (string, error) {
+	if IsUUID(s) {
+		return s, nil
+	}
+	id, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return id.UUID, nil
+}
+
+// IsUUID reports whether s is a UUID in the canonical 8-4-4-4-12 form.
+func IsUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// decodeBase64 decodes s in any of the base64 variants Webex IDs are seen
+// in.
+func decodeBase64(s string) (string, bool) {
+	for _, enc := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.RawStdEncoding,
+		base64.URLEncoding,
+		base64.StdEncoding,
+	} {
+		if decoded, err := enc.DecodeString(s); err == nil {
+			return string(decoded), true
+		}
+	}
+	return "", false
+}
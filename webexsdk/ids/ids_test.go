@@ -0,0 +1,99 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package ids
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+const roomUUID = "bbceb1ad-43f1-3b58-9147-f14bb0c4d154"
+
+func TestEncodeAndParse(t *testing.T) {
+	for _, cluster := range []Cluster{ClusterUS, ClusterEU} {
+		encoded, err := Encode(cluster, KindRoom, roomUUID)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		id, err := Parse(encoded)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if id.Cluster != cluster || id.Kind != KindRoom || id.UUID != roomUUID {
+			t.Errorf("Unexpected ID: %+v", id)
+		}
+	}
+
+	if got := New(KindPeople, roomUUID).URI(); got != "ciscospark://us/PEOPLE/"+roomUUID {
+		t.Errorf("Unexpected URI: %s", got)
+	}
+	if _, err := Encode(ClusterUS, KindRoom, "not-a-uuid"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Expected ErrInvalidID, got %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	uri := "ciscospark://us/MESSAGE/" + roomUUID
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"raw url", base64.RawURLEncoding.EncodeToString([]byte(uri)), true},
+		{"padded std", base64.StdEncoding.EncodeToString([]byte(uri)), true},
+		{"non-uuid suffix", base64.StdEncoding.EncodeToString([]byte("ciscospark://us/LICENSE/org_lic")), true},
+		{"uuid", roomUUID, false},
+		{"other scheme", base64.StdEncoding.EncodeToString([]byte("https://example.com/a/b")), false},
+		{"missing kind", base64.StdEncoding.EncodeToString([]byte("ciscospark://" + roomUUID)), false},
+		{"empty", "", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.input)
+			if tc.valid && err != nil {
+				t.Errorf("Expected valid ID, got %v", err)
+			}
+			if !tc.valid && !errors.Is(err, ErrInvalidID) {
+				t.Errorf("Expected ErrInvalidID, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	roomID := New(KindRoom, roomUUID).String()
+
+	if err := Validate(roomID, KindRoom); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+	if err := Validate(roomID, KindPeople); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Expected ErrInvalidID for wrong kind, got %v", err)
+	}
+
+	// FromUUID accepts either form
+	for _, input := range []string{roomUUID, roomID} {
+		got, err := FromUUID(ClusterUS, KindRoom, input)
+		if err != nil || got != roomID {
+			t.Errorf("FromUUID(%q) = %q, %v; expected %q", input, got, err, roomID)
+		}
+	}
+	if _, err := FromUUID(ClusterUS, KindMessage, roomID); err == nil {
+		t.Error("Expected error converting a room ID to a message ID")
+	}
+
+	// ToUUID accepts either form
+	for _, input := range []string{roomUUID, roomID} {
+		got, err := ToUUID(input)
+		if err != nil || got != roomUUID {
+			t.Errorf("ToUUID(%q) = %q, %v; expected %q", input, got, err, roomUUID)
+		}
+	}
+	if _, err := ToUUID("test-room-id"); err == nil {
+		t.Error("Expected error for neither a UUID nor a Hydra ID")
+	}
+}
@@ -0,0 +1,198 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+)
+
+// FirstPageFunc fetches the first page of a listing. It returns the decoded
+// items along with the underlying Page, whose Link header URLs the Iterator
+// follows to fetch the remaining pages.
+type FirstPageFunc[T any] func(ctx context.Context) ([]T, *Page, error)
+
+// Iterator walks every item of a paginated listing, fetching further pages
+// from the Link header as needed. It follows the bufio.Scanner pattern:
+//
+//	it := client.Memberships().ListAll(ctx, &memberships.ListOptions{RoomID: roomID})
+//	for it.Next() {
+//		m := it.Item()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	ctx   context.Context
+	first FirstPageFunc[T]
+	page  *Page
+
+	items []T
+	index int
+	item  T
+
+	started bool
+	limit   int
+	count   int
+	err     error
+}
+
+// NewIterator creates an Iterator that calls first to fetch the first page
+// and then follows the page's next links. Pages are fetched lazily and
+// stop being fetched once ctx is done.
+func NewIterator[T any](ctx context.Context, first FirstPageFunc[T]) *Iterator[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &Iterator[T]{
+		ctx:   ctx,
+		first: first,
+	}
+}
+
+// Limit caps the total number of items the Iterator yields. Zero or a
+// negative value means no cap. It returns the Iterator for chaining and
+// must be called before the first call to Next.
+func (it *Iterator[T]) Limit(n int) *Iterator[T] {
+	it.limit = n
+	return it
+}
+
+// Next advances to the next item, fetching the next page when the current
+// one is exhausted. It returns false when there are no more items, the
+// limit has been reached, or an error occurred (see Err).
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.limit > 0 && it.count >= it.limit {
+		return false
+	}
+
+	for it.index >= len(it.items) {
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.item = it.items[it.index]
+	it.index++
+	it.count++
+	return true
+}
+
+// fetch loads the next page into the buffer. It returns false when there
+// are no more pages or an error occurred.
+func (it *Iterator[T]) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		items, page, err := it.first(it.ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items, it.page, it.index = items, page, 0
+		return true
+	}
+
+	if it.page == nil || !it.page.HasNext {
+		return false
+	}
+
+	client := it.page.Client
+	resp, err := client.RequestURLWithRetry(it.ctx, http.MethodGet, it.page.NextPage, nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	page, err := NewPage(resp, client, it.page.Resource)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	items, err := DecodeItems[T](page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.items, it.page, it.index = items, page, 0
+	return true
+}
+
+// Item returns the current item. It is only valid after Next returned true.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Page returns the page the current item belongs to, or nil before the
+// first call to Next. Its NextPage can be saved as a cursor for
+// Client.PageFromCursor.
+func (it *Iterator[T]) Page() *Page {
+	return it.page
+}
+
+// All adapts the Iterator to an iter.Seq2 for use with range. A non-nil
+// error is yielded once as the final element.
+//
+//	for m, err := range client.Memberships().All(ctx, opts) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// Collect drains the Iterator into a slice. On error it returns the items
+// collected so far along with the error.
+func (it *Iterator[T]) Collect() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// DecodeItems unmarshals the raw items of a Page into a slice of T.
+func DecodeItems[T any](page *Page) ([]T, error) {
+	items := make([]T, len(page.Items))
+	for i, raw := range page.Items {
+		if err := json.Unmarshal(raw, &items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
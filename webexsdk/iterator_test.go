@@ -0,0 +1,167 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type iterItem struct {
+	ID string `json:"id"`
+}
+
+// newIteratorServer serves three pages of two items each, linked with
+// Link headers, and returns a client pointed at it.
+func newIteratorServer(t *testing.T, requests *int) *Client {
+	t.Helper()
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/items":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/page2>; rel="next"`, serverURL))
+			_, _ = fmt.Fprintln(w, `{"items": [{"id": "1"}, {"id": "2"}]}`)
+		case "/page2":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/page3>; rel="next"`, serverURL))
+			_, _ = fmt.Fprintln(w, `{"items": [{"id": "3"}, {"id": "4"}]}`)
+		case "/page3":
+			_, _ = fmt.Fprintln(w, `{"items": [{"id": "5"}, {"id": "6"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintln(w, `{"message": "not found"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL = server.URL
+
+	client, err := NewClient("test-token", &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		Timeout:    5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func firstPage(client *Client, path string) FirstPageFunc[iterItem] {
+	return func(ctx context.Context) ([]iterItem, *Page, error) {
+		resp, err := client.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		page, err := NewPage(resp, client, ResourceItems)
+		if err != nil {
+			return nil, nil, err
+		}
+		items, err := DecodeItems[iterItem](page)
+		return items, page, err
+	}
+}
+
+func TestIterator_AllPages(t *testing.T) {
+	requests := 0
+	client := newIteratorServer(t, &requests)
+
+	it := NewIterator(context.Background(), firstPage(client, "items"))
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Item().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5 6]" {
+		t.Errorf("Expected ids [1 2 3 4 5 6], got %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestIterator_Limit(t *testing.T) {
+	requests := 0
+	client := newIteratorServer(t, &requests)
+
+	items, err := NewIterator(context.Background(), firstPage(client, "items")).Limit(3).Collect()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 3 {
+		t.Errorf("Expected 3 items, got %d", len(items))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestIterator_Seq2(t *testing.T) {
+	requests := 0
+	client := newIteratorServer(t, &requests)
+
+	count := 0
+	for item, err := range NewIterator(context.Background(), firstPage(client, "items")).All() {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		count++
+		if item.ID == "3" {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("Expected to stop after 3 items, got %d", count)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests after early break, got %d", requests)
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	requests := 0
+	client := newIteratorServer(t, &requests)
+
+	var gotErr error
+	for _, err := range NewIterator(context.Background(), firstPage(client, "missing")).All() {
+		gotErr = err
+	}
+	if !IsNotFound(gotErr) {
+		t.Errorf("Expected NotFoundError, got %v", gotErr)
+	}
+}
+
+func TestIterator_ContextCanceled(t *testing.T) {
+	requests := 0
+	client := newIteratorServer(t, &requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewIterator(ctx, firstPage(client, "items"))
+	for i := 0; i < 2; i++ {
+		if !it.Next() {
+			t.Fatalf("Expected item %d", i)
+		}
+	}
+	cancel()
+
+	if it.Next() {
+		t.Error("Expected Next to return false after cancel")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", it.Err())
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
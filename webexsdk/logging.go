@@ -0,0 +1,55 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// printfWriter adapts a Logger to an io.Writer, one Printf call per record.
+type printfWriter struct {
+	logger Logger
+}
+
+func (w printfWriter) Write(p []byte) (int, error) {
+	w.logger.Printf("%s", strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// newPrintfSlog returns a *slog.Logger that formats records as text and
+// passes them to logger.Printf. Records below Info are dropped.
+func newPrintfSlog(logger Logger) *slog.Logger {
+	return slog.New(slog.NewTextHandler(printfWriter{logger: logger}, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// The Printf logger adds its own timestamp
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+// slogPrintfLogger adapts a *slog.Logger to the Logger interface, logging
+// each Printf call at Info level.
+type slogPrintfLogger struct {
+	logger *slog.Logger
+}
+
+func (l slogPrintfLogger) Printf(format string, v ...any) {
+	l.logger.Log(context.Background(), slog.LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Slog returns the structured logger used by the SDK. Packages built on
+// the client log through it, so records carry fields such as trackingId,
+// deviceURL, callId and conversationId.
+func (c *Client) Slog() *slog.Logger {
+	return c.slog
+}
@@ -0,0 +1,79 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestSlog_RequestRecordsTrackingID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("TrackingID", "ROUTER_abc")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		Slog:       slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+
+	resp, err := client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Expected one JSON record, got %q: %v", buf.String(), err)
+	}
+	if record["msg"] != "webex request" || record["level"] != "DEBUG" {
+		t.Errorf("Unexpected record: %v", record)
+	}
+	if record["trackingId"] != "ROUTER_abc" || record["resource"] != "rooms" || record["status"] != float64(200) {
+		t.Errorf("Expected structured request fields, got %v", record)
+	}
+
+	// GetLogger adapts the structured logger for Printf callers
+	client.GetLogger().Printf("hello %s", "world")
+	if !strings.Contains(buf.String(), `"msg":"hello world"`) {
+		t.Errorf("Expected Printf to be routed through Slog, got %s", buf.String())
+	}
+}
+
+func TestSlog_PrintfLoggerFallback(t *testing.T) {
+	logger := &recordingLogger{}
+	client, _ := NewClient("test-token", &Config{Logger: logger})
+
+	client.Slog().Debug("dropped")
+	client.Slog().Warn("token refresh failed", "trackingId", "ROUTER_1")
+
+	if len(logger.lines) != 1 {
+		t.Fatalf("Expected 1 line (debug dropped), got %v", logger.lines)
+	}
+	if logger.lines[0] != `level=WARN msg="token refresh failed" trackingId=ROUTER_1` {
+		t.Errorf("Unexpected line: %q", logger.lines[0])
+	}
+}
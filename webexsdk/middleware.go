@@ -0,0 +1,44 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import "net/http"
+
+// RoundTripperFunc adapts an ordinary function to an http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the RoundTripper that sends a request. It can inspect or
+// modify the request, short-circuit it with its own response, or inspect
+// the response before returning it. For example, to add a header:
+//
+//	func(next http.RoundTripper) http.RoundTripper {
+//		return webexsdk.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//			req.Header.Set("X-Request-Source", "billing-service")
+//			return next.RoundTrip(req)
+//		})
+//	}
+//
+// Middleware runs once per attempt, after authentication and default
+// headers have been set, so retried requests pass through it again.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// chainMiddleware wraps base with middleware so that the first entry is
+// the outermost, i.e. it sees the request first and the response last.
+func chainMiddleware(base http.RoundTripper, middleware []Middleware) http.RoundTripper {
+	rt := base
+	for i := len(middleware) - 1; i >= 0; i-- {
+		if middleware[i] != nil {
+			rt = middleware[i](rt)
+		}
+	}
+	return rt
+}
@@ -0,0 +1,142 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				calls = append(calls, name+">")
+				mu.Unlock()
+				resp, err := next.RoundTrip(req)
+				mu.Lock()
+				calls = append(calls, "<"+name)
+				mu.Unlock()
+				return resp, err
+			})
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		Middleware: []Middleware{record("outer"), nil, record("inner")},
+	})
+
+	resp, err := client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := strings.Join(calls, " "); got != "outer> inner> <inner <outer" {
+		t.Errorf("Unexpected call order: %s", got)
+	}
+}
+
+func TestMiddleware_CoversAllRequestPaths(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signed") != "yes" {
+			t.Errorf("Expected X-Signed header on %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		Middleware: []Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("Authorization") != "Bearer test-token" && req.URL.Path != "/direct" {
+					t.Errorf("Expected auth to be set before middleware on %s", req.URL.Path)
+				}
+				seen = append(seen, req.URL.Path)
+				req.Header.Set("X-Signed", "yes")
+				return next.RoundTrip(req)
+			})
+		}},
+	})
+
+	ctx := context.Background()
+	closeBody := func(resp *http.Response, err error) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+	closeBody(client.RequestWithRetry(ctx, http.MethodGet, "rooms", nil, nil))
+	closeBody(client.RequestURLWithRetry(ctx, http.MethodGet, server.URL+"/page2", nil))
+	closeBody(client.RequestMultipartWithRetry(ctx, "messages", []MultipartField{{Name: "text", Value: "hi"}}, nil))
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/direct", nil)
+	closeBody(client.Do(req))
+
+	if got := strings.Join(seen, " "); got != "/rooms /page2 /messages /direct" {
+		t.Errorf("Unexpected paths seen by middleware: %s", got)
+	}
+}
+
+func TestMiddleware_FaultInjectionIsRetried(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:        server.URL,
+		HttpClient:     server.Client(),
+		MaxRetries:     2,
+		RetryBaseDelay: 1,
+		Middleware: []Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts == 1 {
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Header:     make(http.Header),
+						Body:       io.NopCloser(strings.NewReader("")),
+						Request:    req,
+					}, nil
+				}
+				return next.RoundTrip(req)
+			})
+		}},
+	})
+
+	resp, err := client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 after injected 503, got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
@@ -0,0 +1,163 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+)
+
+// multipartSource is an opened MultipartFile.
+type multipartSource struct {
+	file   *MultipartFile
+	reader io.ReadCloser
+	size   int64 // -1 if unknown
+}
+
+// open opens the content of f.
+func (f *MultipartFile) open() (*multipartSource, error) {
+	switch {
+	case f.Open != nil:
+		r, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("error opening file %s: %w", f.FileName, err)
+		}
+		return &multipartSource{file: f, reader: r, size: knownSize(f.Size)}, nil
+	case f.Reader != nil:
+		return &multipartSource{file: f, reader: io.NopCloser(f.Reader), size: knownSize(f.Size)}, nil
+	default:
+		return &multipartSource{file: f, reader: io.NopCloser(bytes.NewReader(f.Content)), size: int64(len(f.Content))}, nil
+	}
+}
+
+// knownSize maps zero and negative sizes to -1 (unknown).
+func knownSize(size int64) int64 {
+	if size <= 0 {
+		return -1
+	}
+	return size
+}
+
+// newMultipartBody opens files and returns a body that streams fields and
+// files through a pipe, its Content-Type and its length, or -1 if the size
+// of any file is unknown. Closing the body stops the stream and closes the
+// files.
+func newMultipartBody(fields []MultipartField, files []MultipartFile) (io.ReadCloser, string, int64, error) {
+	sources := make([]*multipartSource, 0, len(files))
+	closeAll := func() {
+		for _, src := range sources {
+			_ = src.reader.Close()
+		}
+	}
+	for i := range files {
+		src, err := files[i].open()
+		if err != nil {
+			closeAll()
+			return nil, "", 0, err
+		}
+		sources = append(sources, src)
+	}
+
+	// Measure the multipart framing by writing it without the file content
+	var framing countingWriter
+	skeleton := multipart.NewWriter(&framing)
+	length := int64(0)
+	if err := writeMultipart(skeleton, fields, sources, func(_ io.Writer, src *multipartSource) error {
+		if src.size < 0 || length < 0 {
+			length = -1
+		} else {
+			length += src.size
+		}
+		return nil
+	}); err != nil {
+		closeAll()
+		return nil, "", 0, err
+	}
+	if length >= 0 {
+		length += framing.n
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	if err := writer.SetBoundary(skeleton.Boundary()); err != nil {
+		closeAll()
+		return nil, "", 0, err
+	}
+
+	go func() {
+		defer closeAll()
+		err := writeMultipart(writer, fields, sources, func(part io.Writer, src *multipartSource) error {
+			var r io.Reader = src.reader
+			if src.file.Progress != nil {
+				r = &progressReader{r: r, total: src.size, progress: src.file.Progress}
+			}
+			if _, err := io.Copy(part, r); err != nil {
+				return fmt.Errorf("error writing file %s: %w", src.file.FileName, err)
+			}
+			return nil
+		})
+		_ = pw.CloseWithError(err)
+	}()
+
+	return pr, writer.FormDataContentType(), length, nil
+}
+
+// writeMultipart writes fields and the parts of sources to w, calling
+// content to write each part's content, and closes w.
+func writeMultipart(w *multipart.Writer, fields []MultipartField, sources []*multipartSource, content func(part io.Writer, src *multipartSource) error) error {
+	// Write text fields
+	for _, f := range fields {
+		if err := w.WriteField(f.Name, f.Value); err != nil {
+			return fmt.Errorf("error writing field %s: %w", f.Name, err)
+		}
+	}
+
+	// Write file parts
+	for _, src := range sources {
+		part, err := w.CreateFormFile(src.file.FieldName, src.file.FileName)
+		if err != nil {
+			return fmt.Errorf("error creating form file %s: %w", src.file.FileName, err)
+		}
+		if err := content(part, src); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error closing multipart writer: %w", err)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// progressReader reports the bytes read from r.
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.sent, r.total)
+	}
+	return n, err
+}
@@ -0,0 +1,196 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultAuthorizeURL is the Webex OAuth authorization endpoint.
+	DefaultAuthorizeURL = "https://webexapis.com/v1/authorize"
+
+	// DefaultTokenURL is the Webex OAuth token endpoint.
+	DefaultTokenURL = "https://webexapis.com/v1/access_token"
+)
+
+// OAuthConfig describes a Webex Integration for the OAuth 2.0
+// authorization-code flow.
+type OAuthConfig struct {
+	// ClientID and ClientSecret identify the Webex Integration.
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURI must match one of the Integration's registered redirect URIs.
+	RedirectURI string
+
+	// Scopes requested from the user, e.g. "spark:messages_read".
+	Scopes []string
+
+	// AuthorizeURL is the authorization endpoint. Default: the authorize
+	// endpoint of Environment, or DefaultAuthorizeURL.
+	AuthorizeURL string
+
+	// TokenURL is the token endpoint. Default: the access_token endpoint of
+	// Environment, or DefaultTokenURL.
+	TokenURL string
+
+	// Environment, if set, supplies the default AuthorizeURL and TokenURL,
+	// e.g. FedRAMP for Webex for Government integrations.
+	Environment *Environment
+
+	// HttpClient is used for token requests. If nil, a client with a 30s
+	// timeout is used.
+	HttpClient *http.Client
+}
+
+// tokenResponse is the JSON body returned by the token endpoint.
+type tokenResponse struct {
+	AccessToken           string `json:"access_token"`
+	ExpiresIn             int64  `json:"expires_in"`
+	RefreshToken          string `json:"refresh_token"`
+	RefreshTokenExpiresIn int64  `json:"refresh_token_expires_in"`
+}
+
+// AuthCodeURL returns the URL to send the user to in order to authorize the
+// Integration. state is echoed back to the redirect URI and should be an
+// unguessable value tied to the user's session.
+func (o *OAuthConfig) AuthCodeURL(state string) string {
+	authorizeURL := o.AuthorizeURL
+	if authorizeURL == "" {
+		authorizeURL = DefaultAuthorizeURL
+		if o.Environment != nil {
+			authorizeURL = o.Environment.APIBaseURL + "/authorize"
+		}
+	}
+
+	params := url.Values{}
+	params.Set("client_id", o.ClientID)
+	params.Set("response_type", "code")
+	params.Set("redirect_uri", o.RedirectURI)
+	params.Set("scope", strings.Join(o.Scopes, " "))
+	params.Set("state", state)
+
+	return authorizeURL + "?" + params.Encode()
+}
+
+// Exchange trades an authorization code received on the redirect URI for a
+// Token holding the access and refresh tokens.
+func (o *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	if code == "" {
+		return nil, fmt.Errorf("authorization code is required")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("client_id", o.ClientID)
+	form.Set("client_secret", o.ClientSecret)
+	form.Set("code", code)
+	form.Set("redirect_uri", o.RedirectURI)
+
+	return o.requestToken(ctx, form)
+}
+
+// Refresh obtains a new access token using refreshToken.
+func (o *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, fmt.Errorf("refresh token is required")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("client_id", o.ClientID)
+	form.Set("client_secret", o.ClientSecret)
+	form.Set("refresh_token", refreshToken)
+
+	return o.requestToken(ctx, form)
+}
+
+// TokenSource returns a RefreshingTokenSource that starts from token and
+// renews it with its refresh token before it expires. Pass the result to
+// NewClientWithTokenSource. If config is nil, the default configuration
+// will be used.
+func (o *OAuthConfig) TokenSource(token *Token, config *RefreshingTokenSourceConfig) *RefreshingTokenSource {
+	return NewRefreshingTokenSource(token, func(ctx context.Context, current *Token) (*Token, error) {
+		if current == nil || current.RefreshToken == "" {
+			return nil, fmt.Errorf("no refresh token available")
+		}
+		return o.Refresh(ctx, current.RefreshToken)
+	}, config)
+}
+
+// requestToken posts form to the token endpoint and decodes the Token.
+func (o *OAuthConfig) requestToken(ctx context.Context, form url.Values) (*Token, error) {
+	tokenURL := o.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultTokenURL
+		if o.Environment != nil {
+			tokenURL = o.Environment.APIBaseURL + "/access_token"
+		}
+	}
+	httpClient := o.HttpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTokenResponse(resp)
+}
+
+// ParseTokenResponse decodes a Webex token endpoint response (access_token,
+// expires_in, refresh_token, refresh_token_expires_in) into a Token. Error
+// responses are returned as typed API errors. The response body is closed.
+func ParseTokenResponse(resp *http.Response) (*Token, error) {
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, NewAPIError(resp, body)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("error parsing token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("token response did not include an access token")
+	}
+
+	now := time.Now()
+	token := &Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+	}
+	if tr.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	if tr.RefreshTokenExpiresIn > 0 {
+		token.RefreshTokenExpiry = now.Add(time.Duration(tr.RefreshTokenExpiresIn) * time.Second)
+	}
+	return token, nil
+}
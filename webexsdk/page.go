@@ -0,0 +1,194 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TypedPage is a page of a paginated listing with its items decoded into
+// T. The List methods of the resource packages return TypedPages, e.g.
+// rooms.RoomsPage is a TypedPage[rooms.Room].
+//
+// It embeds the underlying Page for its NextPage and PrevPage links; its
+// Next and Prev methods return TypedPages instead of raw Pages.
+type TypedPage[T any] struct {
+	Items []T `json:"items"`
+	*Page
+}
+
+// NewTypedPage decodes the items of page into a TypedPage.
+func NewTypedPage[T any](page *Page) (*TypedPage[T], error) {
+	items, err := DecodeItems[T](page)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedPage[T]{Items: items, Page: page}, nil
+}
+
+// HasNext reports whether there is a next page.
+func (p *TypedPage[T]) HasNext() bool {
+	return p.Page != nil && p.Page.HasNext
+}
+
+// HasPrev reports whether there is a previous page.
+func (p *TypedPage[T]) HasPrev() bool {
+	return p.Page != nil && p.Page.HasPrev
+}
+
+// Next retrieves the next page of results using the URL from the Link header.
+func (p *TypedPage[T]) Next(ctx context.Context) (*TypedPage[T], error) {
+	if !p.HasNext() {
+		return nil, fmt.Errorf("no next page")
+	}
+	page, err := p.Page.NextCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedPage[T](page)
+}
+
+// Prev retrieves the previous page of results using the URL from the Link header.
+func (p *TypedPage[T]) Prev(ctx context.Context) (*TypedPage[T], error) {
+	if !p.HasPrev() {
+		return nil, fmt.Errorf("no previous page")
+	}
+	page, err := p.Page.PrevCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedPage[T](page)
+}
+
+// NextCursor returns a Cursor for the next page, or false if there is none.
+func (p *TypedPage[T]) NextCursor() (Cursor, bool) {
+	if !p.HasNext() {
+		return Cursor{}, false
+	}
+	return Cursor{URL: p.Page.NextPage, Resource: p.Page.Resource}, true
+}
+
+// PrevCursor returns a Cursor for the previous page, or false if there is
+// none.
+func (p *TypedPage[T]) PrevCursor() (Cursor, bool) {
+	if !p.HasPrev() {
+		return Cursor{}, false
+	}
+	return Cursor{URL: p.Page.PrevPage, Resource: p.Page.Resource}, true
+}
+
+// All returns an Iterator over the items of this page and every page after
+// it.
+func (p *TypedPage[T]) All(ctx context.Context) *Iterator[T] {
+	return NewIterator(ctx, func(ctx context.Context) ([]T, *Page, error) {
+		return p.Items, p.Page, nil
+	})
+}
+
+// Cursor identifies a page of a listing so that it can be fetched again
+// later, e.g. to resume an export after a restart. It marshals to an
+// opaque string with MarshalText (and therefore to a JSON string), which
+// ParseCursor and UnmarshalText read back.
+//
+//	cursor, ok := page.NextCursor()
+//	saved := cursor.String() // store in a database, file, ...
+//
+//	// After a restart
+//	cursor, err := webexsdk.ParseCursor(saved)
+//	page, err := webexsdk.FetchPage[rooms.Room](ctx, client, cursor)
+type Cursor struct {
+	// URL is the absolute URL of the page, taken from a Link header.
+	URL string `json:"url"`
+
+	// Resource is the listing's resource, if known.
+	Resource Resource `json:"resource,omitempty"`
+}
+
+// cursorJSON is the encoded form of a Cursor. It is a separate type so
+// that encoding does not recurse into MarshalText.
+type cursorJSON struct {
+	URL      string   `json:"url"`
+	Resource Resource `json:"resource,omitempty"`
+}
+
+// ParseCursor parses a cursor produced by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	var c Cursor
+	err := c.UnmarshalText([]byte(s))
+	return c, err
+}
+
+// IsZero reports whether c is the zero Cursor.
+func (c Cursor) IsZero() bool {
+	return c.URL == ""
+}
+
+// String returns the encoded cursor.
+func (c Cursor) String() string {
+	text, _ := c.MarshalText()
+	return string(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Cursor) MarshalText() ([]byte, error) {
+	data, err := json.Marshal(cursorJSON(c))
+	if err != nil {
+		return nil, err
+	}
+	text := make([]byte, base64.RawURLEncoding.EncodedLen(len(data)))
+	base64.RawURLEncoding.Encode(text, data)
+	return text, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Cursor) UnmarshalText(text []byte) error {
+	data := make([]byte, base64.RawURLEncoding.DecodedLen(len(text)))
+	n, err := base64.RawURLEncoding.Decode(data, text)
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	var decoded cursorJSON
+	if err := json.Unmarshal(data[:n], &decoded); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	if decoded.URL == "" {
+		return fmt.Errorf("invalid cursor: missing URL")
+	}
+	*c = Cursor(decoded)
+	return nil
+}
+
+// FetchPage fetches the page identified by cursor and decodes its items
+// into T. The cursor's URL must point at the client's API host, so that a
+// tampered cursor cannot send the access token elsewhere.
+func FetchPage[T any](ctx context.Context, client *Client, cursor Cursor) (*TypedPage[T], error) {
+	if cursor.IsZero() {
+		return nil, fmt.Errorf("cursor URL is empty")
+	}
+	u, err := url.Parse(cursor.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor URL: %w", err)
+	}
+	if u.Scheme != client.BaseURL.Scheme || u.Host != client.BaseURL.Host {
+		return nil, fmt.Errorf("cursor URL host %q does not match API host %q", u.Host, client.BaseURL.Host)
+	}
+
+	resp, err := client.RequestURLWithRetry(ctx, http.MethodGet, cursor.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	page, err := NewPage(resp, client, cursor.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedPage[T](page)
+}
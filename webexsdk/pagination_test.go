@@ -7,6 +7,7 @@
 package webexsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -679,3 +680,121 @@ func TestPageFromCursor_MultiPageCollect(t *testing.T) {
 		}
 	}
 }
+
+// --- TypedPage and Cursor tests ---
+
+type pageItem struct {
+	ID string `json:"id"`
+}
+
+func newTypedPageServer(t *testing.T) *Client {
+	t.Helper()
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/items?cursor=2>; rel="next"`, serverURL))
+			_, _ = fmt.Fprintln(w, `{"items": [{"id": "item1"}, {"id": "item2"}]}`)
+		case "2":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/items>; rel="prev"`, serverURL))
+			_, _ = fmt.Fprintln(w, `{"items": [{"id": "item3"}]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL = server.URL
+
+	client, _ := NewClient("test-token", &Config{BaseURL: server.URL, HttpClient: server.Client()})
+	return client
+}
+
+func TestTypedPage_NextPrev(t *testing.T) {
+	client := newTypedPageServer(t)
+	ctx := context.Background()
+
+	resp, err := client.RequestWithRetry(ctx, http.MethodGet, "items", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to get first page: %v", err)
+	}
+	raw, err := NewPage(resp, client, ResourceItems)
+	if err != nil {
+		t.Fatalf("Failed to create page: %v", err)
+	}
+	page, err := NewTypedPage[pageItem](raw)
+	if err != nil {
+		t.Fatalf("Failed to decode page: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].ID != "item1" || !page.HasNext() || page.HasPrev() {
+		t.Fatalf("Unexpected first page: %+v", page.Items)
+	}
+
+	next, err := page.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	if len(next.Items) != 1 || next.Items[0].ID != "item3" || next.HasNext() || !next.HasPrev() {
+		t.Fatalf("Unexpected next page: %+v", next.Items)
+	}
+	if _, err := next.Next(ctx); err == nil {
+		t.Error("Expected error past the last page")
+	}
+
+	prev, err := next.Prev(ctx)
+	if err != nil {
+		t.Fatalf("Prev failed: %v", err)
+	}
+	if len(prev.Items) != 2 || prev.Items[1].ID != "item2" {
+		t.Errorf("Unexpected previous page: %+v", prev.Items)
+	}
+
+	all, err := page.All(ctx).Collect()
+	if err != nil || len(all) != 3 {
+		t.Errorf("Expected 3 items from All, got %d (%v)", len(all), err)
+	}
+}
+
+func TestCursor_Resume(t *testing.T) {
+	client := newTypedPageServer(t)
+	ctx := context.Background()
+
+	resp, _ := client.RequestWithRetry(ctx, http.MethodGet, "items", nil, nil)
+	raw, _ := NewPage(resp, client, ResourceItems)
+	page, _ := NewTypedPage[pageItem](raw)
+
+	cursor, ok := page.NextCursor()
+	if !ok {
+		t.Fatal("Expected a next cursor")
+	}
+
+	// Round-trip through JSON as an export checkpoint would
+	data, err := json.Marshal(map[string]Cursor{"cursor": cursor})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var saved map[string]Cursor
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	parsed, err := ParseCursor(cursor.String())
+	if err != nil || parsed != cursor || saved["cursor"] != cursor {
+		t.Fatalf("Cursor did not round-trip: %+v, %+v (%v)", parsed, saved["cursor"], err)
+	}
+
+	resumed, err := FetchPage[pageItem](ctx, client, saved["cursor"])
+	if err != nil {
+		t.Fatalf("FetchPage failed: %v", err)
+	}
+	if len(resumed.Items) != 1 || resumed.Items[0].ID != "item3" || resumed.Resource != ResourceItems {
+		t.Errorf("Unexpected resumed page: %+v", resumed)
+	}
+
+	foreign := Cursor{URL: "https://attacker.example/items?cursor=2"}
+	if _, err := FetchPage[pageItem](ctx, client, foreign); err == nil {
+		t.Error("Expected cursor for a foreign host to be rejected")
+	}
+	for _, bad := range []string{"", "!!", "e30"} {
+		if _, err := ParseCursor(bad); err == nil {
+			t.Errorf("Expected ParseCursor(%q) to fail", bad)
+		}
+	}
+}
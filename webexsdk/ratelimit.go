@@ -0,0 +1,244 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is the token-bucket rate for one resource.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate. Zero or a negative
+	// value disables rate limiting for the resource.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that may be sent at once before the
+	// rate applies. Default: RequestsPerSecond rounded up, at least 1.
+	Burst int
+}
+
+// RateLimiterConfig holds the configuration for a RateLimiter
+type RateLimiterConfig struct {
+	// Default is the rate applied to each resource without an entry in
+	// Resources. Every resource gets its own bucket at this rate.
+	Default RateLimit
+
+	// Resources overrides the rate for individual resources, e.g.
+	// ResourcePeople or ResourceMemberships.
+	Resources map[Resource]RateLimit
+
+	// MaxInFlight caps the number of requests awaiting a response across
+	// all resources. Zero means no cap.
+	MaxInFlight int
+
+	// DefaultPause is how long a resource is paused after a 429 response
+	// without a Retry-After header. Default: 1s.
+	DefaultPause time.Duration
+
+	// Parent is a limiter shared with other RateLimiters, e.g. the global
+	// limit of a webex.Pool. Requests wait for it after their own bucket,
+	// but pauses are not propagated to it, so one token being rate limited
+	// does not hold up the others.
+	Parent *RateLimiter
+}
+
+// RateLimiter throttles requests with a token bucket per resource and an
+// optional cap on in-flight requests. When any request receives a 429, the
+// resource's bucket is paused for the Retry-After duration, so every
+// goroutine using the limiter backs off together.
+//
+// A RateLimiter is safe for concurrent use and may be shared by several
+// clients through Config.RateLimiter.
+type RateLimiter struct {
+	config   *RateLimiterConfig
+	inFlight chan struct{}
+
+	mu      sync.Mutex
+	buckets map[Resource]*bucket
+}
+
+// bucket is a token bucket that can be paused.
+type bucket struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter.
+// If config is nil, requests are not rate limited but 429 responses still
+// pause their resource.
+func NewRateLimiter(config *RateLimiterConfig) *RateLimiter {
+	if config == nil {
+		config = &RateLimiterConfig{}
+	}
+	if config.DefaultPause <= 0 {
+		config.DefaultPause = 1 * time.Second
+	}
+
+	l := &RateLimiter{
+		config:  config,
+		buckets: make(map[Resource]*bucket),
+	}
+	if config.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, config.MaxInFlight)
+	}
+	return l
+}
+
+// Wait blocks until a request to resource may be sent, or ctx is done. On
+// success it returns a release function that must be called once the
+// response has been received.
+func (l *RateLimiter) Wait(ctx context.Context, resource Resource) (release func(), err error) {
+	b := l.bucket(resource)
+	for {
+		delay := b.take(time.Now())
+		if delay <= 0 {
+			break
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	releaseParent := func() {}
+	if parent := l.config.Parent; parent != nil {
+		release, err := parent.Wait(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		releaseParent = release
+	}
+
+	if l.inFlight == nil {
+		return releaseParent, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		releaseParent()
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-l.inFlight
+			releaseParent()
+		})
+	}, nil
+}
+
+// Pause stops requests to resource for d. A shorter pause does not cut an
+// existing one short. If d is zero or negative, DefaultPause is used.
+func (l *RateLimiter) Pause(resource Resource, d time.Duration) {
+	if d <= 0 {
+		d = l.config.DefaultPause
+	}
+	b := l.bucket(resource)
+	until := time.Now().Add(d)
+
+	b.mu.Lock()
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	b.mu.Unlock()
+}
+
+// PausedUntil returns the time until which resource is paused, or the zero
+// time if it is not paused.
+func (l *RateLimiter) PausedUntil(resource Resource) time.Time {
+	b := l.bucket(resource)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if time.Now().After(b.pausedUntil) {
+		return time.Time{}
+	}
+	return b.pausedUntil
+}
+
+// bucket returns the bucket for resource, creating it on first use.
+func (l *RateLimiter) bucket(resource Resource) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[resource]; ok {
+		return b
+	}
+
+	limit, ok := l.config.Resources[resource]
+	if !ok {
+		limit = l.config.Default
+	}
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+	}
+
+	b := &bucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+	l.buckets[resource] = b
+	return b
+}
+
+// take consumes a token and returns zero, or returns how long to wait
+// before trying again if the bucket is paused or empty.
+func (b *bucket) take(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// multiSegmentResources are resources whose path spans two segments.
+var multiSegmentResources = map[string]Resource{
+	string(ResourceTeamMemberships):   ResourceTeamMemberships,
+	string(ResourceRoomTabs):          ResourceRoomTabs,
+	string(ResourceAttachmentActions): ResourceAttachmentActions,
+}
+
+// resourceFromURL derives the rate limiting resource from a request URL,
+// e.g. https://webexapis.com/v1/people/me yields ResourcePeople.
+func resourceFromURL(u *url.URL, basePath string) Resource {
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(basePath, "/"))
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(segments) >= 2 {
+		if r, ok := multiSegmentResources[segments[0]+"/"+segments[1]]; ok {
+			return r
+		}
+	}
+	return Resource(segments[0])
+}
@@ -0,0 +1,217 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResourceFromURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected Resource
+	}{
+		{"https://webexapis.com/v1/people/me", ResourcePeople},
+		{"https://webexapis.com/v1/messages?roomId=abc", ResourceMessages},
+		{"https://webexapis.com/v1/team/memberships/123", ResourceTeamMemberships},
+		{"https://webexapis.com/v1/room/tabs", ResourceRoomTabs},
+		{"https://webexapis.com/v1/attachment/actions/123", ResourceAttachmentActions},
+		{"https://webexapis.com/v1/rooms", ResourceRooms},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		if got := resourceFromURL(u, "/v1"); got != tt.expected {
+			t.Errorf("resourceFromURL(%s) = %q, expected %q", tt.url, got, tt.expected)
+		}
+	}
+}
+
+func TestRateLimiter_TokenBucket(t *testing.T) {
+	l := NewRateLimiter(&RateLimiterConfig{
+		Default: RateLimit{RequestsPerSecond: 1000},
+		Resources: map[Resource]RateLimit{
+			ResourcePeople: {RequestsPerSecond: 20, Burst: 1},
+		},
+	})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		release, err := l.Wait(context.Background(), ResourcePeople)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		release()
+	}
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("Expected 5 requests at 20/s to take ~200ms, took %v", elapsed)
+	}
+
+	// Other resources have their own bucket
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		release, _ := l.Wait(context.Background(), ResourceRooms)
+		release()
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected rooms to be unaffected by people limit, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_PauseAndCancel(t *testing.T) {
+	l := NewRateLimiter(nil)
+	l.Pause(ResourceMessages, time.Hour)
+
+	if l.PausedUntil(ResourceMessages).IsZero() {
+		t.Error("Expected messages to be paused")
+	}
+	if !l.PausedUntil(ResourceRooms).IsZero() {
+		t.Error("Expected rooms not to be paused")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := l.Wait(ctx, ResourceMessages); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded while paused, got %v", err)
+	}
+}
+
+func TestRateLimiter_Parent(t *testing.T) {
+	global := NewRateLimiter(&RateLimiterConfig{MaxInFlight: 1})
+	a := NewRateLimiter(&RateLimiterConfig{Parent: global})
+	b := NewRateLimiter(&RateLimiterConfig{Parent: global})
+
+	// A pause of one child does not affect the other or the parent
+	a.Pause(ResourceMessages, time.Hour)
+	if !global.PausedUntil(ResourceMessages).IsZero() {
+		t.Error("Expected pause not to propagate to the parent")
+	}
+	release, err := b.Wait(context.Background(), ResourceMessages)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The parent's in-flight cap applies across children
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := a.Wait(ctx, ResourceRooms); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded at the parent's cap, got %v", err)
+	}
+	release()
+	release, err = a.Wait(context.Background(), ResourceRooms)
+	if err != nil {
+		t.Fatalf("Expected slot after release, got %v", err)
+	}
+	release()
+}
+
+func TestClientRateLimiter_MaxInFlight(t *testing.T) {
+	var current, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:     server.URL,
+		HttpClient:  server.Client(),
+		RateLimiter: NewRateLimiter(&RateLimiterConfig{MaxInFlight: 2}),
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Request(http.MethodGet, "people/me", nil, nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestClientRateLimiter_429PausesResource(t *testing.T) {
+	var peopleRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/people/me" && atomic.AddInt32(&peopleRequests, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(nil)
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:     server.URL,
+		HttpClient:  server.Client(),
+		MaxRetries:  0,
+		RateLimiter: limiter,
+	})
+
+	resp, err := client.Request(http.MethodGet, "people/me", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %d", resp.StatusCode)
+	}
+	if limiter.PausedUntil(ResourcePeople).IsZero() {
+		t.Fatal("Expected people to be paused after 429")
+	}
+
+	// Another resource is unaffected
+	start := time.Now()
+	resp, err = client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Expected rooms request to proceed immediately")
+	}
+
+	// A request from any goroutine to the paused resource waits it out
+	done := make(chan time.Duration)
+	go func() {
+		start := time.Now()
+		resp, err := client.Request(http.MethodGet, "people/me", nil, nil)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		done <- time.Since(start)
+	}()
+	if waited := <-done; waited < 800*time.Millisecond {
+		t.Errorf("Expected request to wait for the pause, waited %v", waited)
+	}
+}
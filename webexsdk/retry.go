@@ -0,0 +1,178 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// IdempotencyKeyHeader carries the key set with WithIdempotencyKey.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyKey struct{}
+
+type noRetryKey struct{}
+
+// RetryPolicy controls which failed requests the Request methods retry
+// and how long they wait in between. The number of retries and the base
+// delay are Config.MaxRetries and Config.RetryBaseDelay. The zero value
+// is the default policy.
+type RetryPolicy struct {
+	// DisableJitter makes the backoff exactly RetryBaseDelay * 2^attempt.
+	// By default each delay is drawn uniformly from [0, RetryBaseDelay *
+	// 2^attempt) ("full jitter"), so that clients throttled together do
+	// not retry together. Retry-After delays are never jittered.
+	DisableJitter bool
+
+	// MaxDelay caps the backoff between attempts. Retry-After delays are
+	// not capped. If zero, the backoff is not capped.
+	MaxDelay time.Duration
+
+	// IsTransient reports whether a transport error (a failure to get any
+	// response) is worth retrying. If nil, IsTransientError is used.
+	IsTransient func(err error) bool
+
+	// RetryNonIdempotent retries POST and PATCH requests after transport
+	// errors that may have reached the server and after 502, 503 and 504
+	// responses. By default these are retried only if the request carries
+	// an idempotency key (see WithIdempotencyKey). Connection failures and
+	// 429 and 423 responses, after which the request was not processed,
+	// are retried for every method.
+	RetryNonIdempotent bool
+}
+
+// WithIdempotencyKey returns a context that marks requests sent with it as
+// safe to retry regardless of their method. The key is sent as the
+// Idempotency-Key header and should be unique per logical operation.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the key set with WithIdempotencyKey,
+// or "" if there is none.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// withoutRetry returns a context whose requests retryLoop sends only once,
+// for request bodies that cannot be replayed.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// IsTransientError reports whether err, returned while sending a request,
+// is a transient network failure: a refused or reset connection, a
+// connection closed before the response, a timeout (including TLS
+// handshake timeouts) or a temporary DNS failure. Context cancellation is
+// not transient.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isConnectError reports whether err happened before the request was
+// sent, so that retrying cannot duplicate it.
+func isConnectError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isIdempotent reports whether requests with method can be repeated
+// without changing the result.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryPolicy returns the client's RetryPolicy or the default policy.
+func (c *Client) retryPolicy() *RetryPolicy {
+	if c.Config.RetryPolicy != nil {
+		return c.Config.RetryPolicy
+	}
+	return &RetryPolicy{}
+}
+
+// retryableError reports whether a request that failed with err should be
+// retried. replayable reports whether the request may be sent twice.
+func (p *RetryPolicy) retryableError(err error, replayable bool) bool {
+	isTransient := p.IsTransient
+	if isTransient == nil {
+		isTransient = IsTransientError
+	}
+	return isTransient(err) && (replayable || isConnectError(err))
+}
+
+// backoff returns the delay before retry number attempt+1.
+func (p *RetryPolicy) backoff(baseDelay time.Duration, attempt int) time.Duration {
+	delay := baseDelay * (1 << uint(attempt))
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if !p.DisableJitter && delay > 0 {
+		delay = time.Duration(rand.Int64N(int64(delay)))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date. It returns false if the header is absent, invalid or not in
+// the future.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, seconds > 0
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// sleepCtx waits for d or until ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,221 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// failFirst returns middleware that fails the first n attempts with err
+// and counts all attempts.
+func failFirst(n int, err error, attempts *int) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			*attempts++
+			if *attempts <= n {
+				return nil, err
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc, config *Config) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config.BaseURL = server.URL
+	config.HttpClient = server.Client()
+	config.MaxRetries = 3
+	config.RetryBaseDelay = time.Millisecond
+	client, err := NewClient("test-token", config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestRetry_TransportErrors(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+	tests := []struct {
+		name         string
+		method       string
+		err          error
+		ctx          context.Context
+		wantAttempts int
+		wantErr      bool
+	}{
+		{"GET retried after reset", http.MethodGet, reset, context.Background(), 2, false},
+		{"POST not retried after reset", http.MethodPost, reset, context.Background(), 1, true},
+		{"POST retried with idempotency key", http.MethodPost, reset, WithIdempotencyKey(context.Background(), "op-1"), 2, false},
+		{"POST retried after connection refused", http.MethodPost, refused, context.Background(), 2, false},
+		{"GET retried after unexpected EOF", http.MethodGet, fmt.Errorf("read: %w", io.ErrUnexpectedEOF), context.Background(), 2, false},
+		{"permanent error not retried", http.MethodGet, errors.New("x509: certificate signed by unknown authority"), context.Background(), 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			client := newRetryTestClient(t, okHandler, &Config{
+				Middleware: []Middleware{failFirst(1, tt.err, &attempts)},
+			})
+
+			resp, err := client.RequestWithRetry(tt.ctx, tt.method, "messages", nil, nil)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error=%v, got %v", tt.wantErr, err)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetry_IdempotencyKeyHeader(t *testing.T) {
+	var keys []string
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, &Config{})
+
+	ctx := WithIdempotencyKey(context.Background(), "create-room-42")
+	resp, err := client.RequestWithRetry(ctx, http.MethodPost, "rooms", nil, map[string]string{"title": "x"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(keys) != 2 || keys[0] != "create-room-42" || keys[1] != "create-room-42" {
+		t.Errorf("Expected key on both attempts, got %v", keys)
+	}
+}
+
+func TestRetry_NonIdempotentStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		status       int
+		policy       *RetryPolicy
+		wantAttempts int
+	}{
+		{"503 not retried by default", http.StatusServiceUnavailable, nil, 1},
+		{"503 retried when opted in", http.StatusServiceUnavailable, &RetryPolicy{RetryNonIdempotent: true}, 2},
+		{"429 always retried", http.StatusTooManyRequests, nil, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts == 1 {
+					w.WriteHeader(tc.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}, &Config{RetryPolicy: tc.policy})
+
+			resp, err := client.RequestWithRetry(context.Background(), http.MethodPost, "messages", nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+			if attempts != tc.wantAttempts {
+				t.Errorf("Expected %d attempts, got %d", tc.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetry_HTTPDateRetryAfter(t *testing.T) {
+	date := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+
+	d, ok := parseRetryAfter(date)
+	if !ok || d < 85*time.Second || d > 90*time.Second {
+		t.Errorf("Expected ~90s from HTTP date, got %v (ok=%v)", d, ok)
+	}
+	if d, ok := parseRetryAfter("7"); !ok || d != 7*time.Second {
+		t.Errorf("Expected 7s, got %v", d)
+	}
+	past := time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+	for _, h := range []string{"", "soon", past} {
+		if _, ok := parseRetryAfter(h); ok {
+			t.Errorf("Expected %q to be rejected", h)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {date}}}
+	var rl *RateLimitError
+	if !errors.As(NewAPIError(resp, nil), &rl) || rl.RetryAfter < 85*time.Second {
+		t.Errorf("Expected RetryAfter from HTTP date on RateLimitError, got %+v", rl)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	exact := &RetryPolicy{DisableJitter: true, MaxDelay: 5 * time.Second}
+	if d := exact.backoff(time.Second, 2); d != 4*time.Second {
+		t.Errorf("Expected 4s, got %v", d)
+	}
+	if d := exact.backoff(time.Second, 5); d != 5*time.Second {
+		t.Errorf("Expected cap at 5s, got %v", d)
+	}
+
+	jittered := &RetryPolicy{}
+	for i := 0; i < 100; i++ {
+		if d := jittered.backoff(time.Second, 3); d < 0 || d >= 8*time.Second {
+			t.Fatalf("Expected jittered delay in [0, 8s), got %v", d)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{&net.OpError{Op: "read", Err: syscall.ECONNRESET}, true},
+		{&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, true},
+		{&net.DNSError{Err: "server misbehaving", IsTemporary: true}, true},
+		{&net.DNSError{Err: "no such host", IsNotFound: true}, false},
+		{fmt.Errorf("get: %w", io.EOF), true},
+		{timeoutError{}, true},
+		{context.Canceled, false},
+		{context.DeadlineExceeded, false},
+		{errors.New("boom"), false},
+	} {
+		if got := IsTransientError(tc.err); got != tc.want {
+			t.Errorf("IsTransientError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+// timeoutError mimics net/http's TLS handshake timeout error.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "net/http: TLS handshake timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
@@ -0,0 +1,111 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"time"
+)
+
+// Span and metric names emitted by the SDK.
+const (
+	SpanRequest          = "webex.request"
+	SpanMercuryConnect   = "webex.mercury.connect"
+	SpanMercuryReconnect = "webex.mercury.reconnect"
+	SpanKMSFetchKey      = "webex.kms.fetch_key"
+	SpanCallDial         = "webex.calling.dial"
+
+	MetricRequestDuration        = "webex.request.duration"
+	MetricMercuryConnectDuration = "webex.mercury.connect.duration"
+	MetricMercuryReconnects      = "webex.mercury.reconnects"
+	MetricMercuryPingLatency     = "webex.mercury.ping.latency"
+	MetricKMSFetchDuration       = "webex.kms.fetch_key.duration"
+	MetricCallDialDuration       = "webex.calling.dial.duration"
+)
+
+// Attribute keys used on spans and metrics.
+const (
+	AttrHTTPMethod     = "http.request.method"
+	AttrHTTPStatusCode = "http.response.status_code"
+	AttrResource       = "webex.resource"
+	AttrRetryAttempts  = "webex.retry.attempts"
+	AttrTrackingID     = "webex.tracking_id"
+	AttrOutcome        = "webex.outcome"
+)
+
+// Attribute is a key/value pair attached to a span or metric.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Attr returns an Attribute.
+func Attr(key string, value any) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Tracer starts spans. It mirrors the part of OpenTelemetry's trace.Tracer
+// the SDK needs, so an OpenTelemetry tracer can be adapted in a few lines
+// without the SDK depending on it.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is an operation started by a Tracer.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records SDK metrics, typically backed by OpenTelemetry histogram
+// and counter instruments.
+type Meter interface {
+	// RecordDuration records a latency, e.g. MetricRequestDuration.
+	RecordDuration(ctx context.Context, name string, d time.Duration, attrs ...Attribute)
+
+	// AddCount increments a counter, e.g. MetricMercuryReconnects.
+	AddCount(ctx context.Context, name string, delta int64, attrs ...Attribute)
+}
+
+// noopTracer is used when no Tracer is configured.
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+// noopSpan is returned by noopTracer.
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+func (noopSpan) RecordError(err error)            {}
+func (noopSpan) End()                             {}
+
+// noopMeter is used when no Meter is configured.
+type noopMeter struct{}
+
+func (noopMeter) RecordDuration(ctx context.Context, name string, d time.Duration, attrs ...Attribute) {
+}
+func (noopMeter) AddCount(ctx context.Context, name string, delta int64, attrs ...Attribute) {}
+
+// Tracer returns the configured Tracer, or a no-op Tracer if none is set.
+// Packages built on the client use it to instrument their own operations.
+func (c *Client) Tracer() Tracer {
+	if c.Config.Tracer == nil {
+		return noopTracer{}
+	}
+	return c.Config.Tracer
+}
+
+// Meter returns the configured Meter, or a no-op Meter if none is set.
+func (c *Client) Meter() Meter {
+	if c.Config.Meter == nil {
+		return noopMeter{}
+	}
+	return c.Config.Meter
+}
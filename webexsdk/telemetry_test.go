@@ -0,0 +1,132 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memSpan is a span recorded by memTelemetry.
+type memSpan struct {
+	name  string
+	attrs map[string]any
+	errs  []error
+	ended bool
+}
+
+func (s *memSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+func (s *memSpan) RecordError(err error) { s.errs = append(s.errs, err) }
+func (s *memSpan) End()                  { s.ended = true }
+
+type spanKey struct{}
+
+// memTelemetry is an in-memory Tracer and Meter.
+type memTelemetry struct {
+	mu        sync.Mutex
+	spans     []*memSpan
+	durations map[string][]time.Duration
+}
+
+func (m *memTelemetry) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	span := &memSpan{name: name, attrs: make(map[string]any)}
+	span.SetAttributes(attrs...)
+	m.spans = append(m.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (m *memTelemetry) RecordDuration(ctx context.Context, name string, d time.Duration, attrs ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.durations == nil {
+		m.durations = make(map[string][]time.Duration)
+	}
+	m.durations[name] = append(m.durations[name], d)
+}
+
+func (m *memTelemetry) AddCount(ctx context.Context, name string, delta int64, attrs ...Attribute) {}
+
+func TestTelemetry_RequestSpan(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("TrackingID", "ROUTER_123")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tel := &memTelemetry{}
+	var middlewareSpan any
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:        server.URL,
+		HttpClient:     server.Client(),
+		RetryBaseDelay: time.Millisecond,
+		MaxRetries:     2,
+		Tracer:         tel,
+		Meter:          tel,
+		Middleware: []Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				middlewareSpan = req.Context().Value(spanKey{})
+				return next.RoundTrip(req)
+			})
+		}},
+	})
+
+	resp, err := client.Request(http.MethodGet, "people/me", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(tel.spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(tel.spans))
+	}
+	span := tel.spans[0]
+	if span.name != SpanRequest || !span.ended {
+		t.Errorf("Expected ended %s span, got %+v", SpanRequest, span)
+	}
+	expected := map[string]any{
+		AttrHTTPMethod:     http.MethodGet,
+		AttrResource:       "people",
+		AttrHTTPStatusCode: http.StatusOK,
+		AttrRetryAttempts:  1,
+		AttrTrackingID:     "ROUTER_123",
+	}
+	for k, v := range expected {
+		if span.attrs[k] != v {
+			t.Errorf("Expected %s=%v, got %v", k, v, span.attrs[k])
+		}
+	}
+	if middlewareSpan != span {
+		t.Error("Expected middleware to receive the request span's context")
+	}
+	if len(tel.durations[MetricRequestDuration]) != 1 {
+		t.Errorf("Expected 1 %s measurement, got %v", MetricRequestDuration, tel.durations)
+	}
+}
+
+func TestTelemetry_NoopByDefault(t *testing.T) {
+	client, _ := NewClient("test-token", nil)
+	_, span := client.Tracer().Start(context.Background(), "test")
+	span.SetAttributes(Attr("k", "v"))
+	span.End()
+	client.Meter().RecordDuration(context.Background(), "test", time.Second)
+}
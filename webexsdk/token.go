@@ -0,0 +1,186 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrTokenNotRefreshable is returned by Client.RefreshAccessToken when the
+// client's TokenSource cannot refresh tokens (e.g. a static access token).
+var ErrTokenNotRefreshable = errors.New("token source does not support refresh")
+
+// Token is an access token with its optional refresh token and expiry times.
+type Token struct {
+	// AccessToken is the bearer token sent in the Authorization header.
+	AccessToken string `json:"access_token"`
+
+	// RefreshToken is used to obtain a new access token. Empty if not available.
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	// Expiry is when AccessToken expires. The zero value means it never expires.
+	Expiry time.Time `json:"expiry,omitempty"`
+
+	// RefreshTokenExpiry is when RefreshToken expires. Zero if unknown.
+	RefreshTokenExpiry time.Time `json:"refresh_token_expiry,omitempty"`
+}
+
+// expired reports whether the token expires within delta of now.
+func (t *Token) expired(delta time.Duration) bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return time.Now().Add(delta).After(t.Expiry)
+}
+
+// Valid reports whether the token has an access token that has not expired.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && !t.expired(0)
+}
+
+// TokenSource supplies access tokens to the client. Implementations must be
+// safe for concurrent use; Token is called before every request.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenRefresher is a TokenSource that can be forced to fetch a new token,
+// for example after the API rejected the current one with 401.
+type TokenRefresher interface {
+	TokenSource
+	Refresh(ctx context.Context) (*Token, error)
+}
+
+// staticTokenSource always returns the same token.
+type staticTokenSource struct {
+	token *Token
+}
+
+// StaticTokenSource returns a TokenSource that always returns accessToken.
+// This is what NewClient uses for a plain access token string.
+func StaticTokenSource(accessToken string) TokenSource {
+	return &staticTokenSource{token: &Token{AccessToken: accessToken}}
+}
+
+// Token implements TokenSource.
+func (s *staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// RefreshFunc obtains a new token. current is the token being replaced and
+// may be nil if no token has been obtained yet.
+type RefreshFunc func(ctx context.Context, current *Token) (*Token, error)
+
+// RefreshingTokenSourceConfig holds the configuration for a RefreshingTokenSource
+type RefreshingTokenSourceConfig struct {
+	// ExpiryDelta is how long before expiry a token is refreshed. Default: 5m.
+	ExpiryDelta time.Duration
+
+	// OnRefresh is called with every newly obtained token, so callers can
+	// persist rotated refresh tokens. It is called with the source's lock
+	// held and must not call back into the source.
+	OnRefresh func(token *Token)
+}
+
+// RefreshingTokenSource caches a token and obtains a new one through a
+// RefreshFunc shortly before it expires. It is safe for concurrent use;
+// concurrent callers wait for a single refresh.
+type RefreshingTokenSource struct {
+	mu      sync.Mutex
+	token   *Token
+	refresh RefreshFunc
+	config  *RefreshingTokenSourceConfig
+}
+
+// NewRefreshingTokenSource creates a RefreshingTokenSource starting from
+// initial, which may be nil to fetch a token on first use.
+// If config is nil, the default configuration will be used.
+func NewRefreshingTokenSource(initial *Token, refresh RefreshFunc, config *RefreshingTokenSourceConfig) *RefreshingTokenSource {
+	if config == nil {
+		config = &RefreshingTokenSourceConfig{}
+	}
+	if config.ExpiryDelta <= 0 {
+		config.ExpiryDelta = 5 * time.Minute
+	}
+
+	return &RefreshingTokenSource{
+		token:   initial,
+		refresh: refresh,
+		config:  config,
+	}
+}
+
+// Token returns the cached token, refreshing it first if it is missing or
+// about to expire. If the refresh fails but the cached token has not yet
+// expired, the cached token is returned so callers can keep working until
+// the next attempt.
+func (s *RefreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken != "" && !s.token.expired(s.config.ExpiryDelta) {
+		return s.token, nil
+	}
+
+	token, err := s.refreshLocked(ctx)
+	if err != nil {
+		if s.token.Valid() {
+			return s.token, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// Refresh unconditionally obtains a new token.
+func (s *RefreshingTokenSource) Refresh(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refreshLocked(ctx)
+}
+
+// RefreshIfCurrent obtains a new token unless the cached access token is no
+// longer stale, i.e. another caller has already replaced the token that the
+// API rejected. Requests that fail with 401 at the same time thus cause a
+// single refresh.
+func (s *RefreshingTokenSource) RefreshIfCurrent(ctx context.Context, stale string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken != "" && s.token.AccessToken != stale {
+		return s.token, nil
+	}
+	return s.refreshLocked(ctx)
+}
+
+// refreshLocked calls the RefreshFunc and stores its token. s.mu must be held.
+func (s *RefreshingTokenSource) refreshLocked(ctx context.Context) (*Token, error) {
+	token, err := s.refresh(ctx, s.token)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing access token: %w", err)
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("error refreshing access token: empty token returned")
+	}
+
+	// Keep the previous refresh token if the server did not rotate it
+	if token.RefreshToken == "" && s.token != nil {
+		token.RefreshToken = s.token.RefreshToken
+		token.RefreshTokenExpiry = s.token.RefreshTokenExpiry
+	}
+
+	s.token = token
+	if s.config.OnRefresh != nil {
+		s.config.OnRefresh(token)
+	}
+	return token, nil
+}
@@ -0,0 +1,320 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStaticTokenSource(t *testing.T) {
+	ts := StaticTokenSource("static-token")
+	token, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token.AccessToken != "static-token" {
+		t.Errorf("Expected 'static-token', got %q", token.AccessToken)
+	}
+	if !token.Valid() {
+		t.Error("Expected static token to be valid")
+	}
+}
+
+func TestRefreshingTokenSource_RefreshBeforeExpiry(t *testing.T) {
+	var calls int32
+	var persisted *Token
+	ts := NewRefreshingTokenSource(
+		&Token{AccessToken: "old", RefreshToken: "refresh-1", Expiry: time.Now().Add(time.Minute)},
+		func(ctx context.Context, current *Token) (*Token, error) {
+			atomic.AddInt32(&calls, 1)
+			if current.RefreshToken != "refresh-1" {
+				t.Errorf("Expected current refresh token 'refresh-1', got %q", current.RefreshToken)
+			}
+			return &Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+		},
+		&RefreshingTokenSourceConfig{
+			ExpiryDelta: 5 * time.Minute,
+			OnRefresh:   func(token *Token) { persisted = token },
+		},
+	)
+
+	for i := 0; i < 3; i++ {
+		token, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if token.AccessToken != "new" {
+			t.Errorf("Expected refreshed token 'new', got %q", token.AccessToken)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 refresh, got %d", calls)
+	}
+	if persisted == nil || persisted.RefreshToken != "refresh-1" {
+		t.Errorf("Expected OnRefresh with carried-over refresh token, got %+v", persisted)
+	}
+}
+
+func TestRefreshingTokenSource_FailedRefreshKeepsValidToken(t *testing.T) {
+	ts := NewRefreshingTokenSource(
+		&Token{AccessToken: "current", Expiry: time.Now().Add(time.Minute)},
+		func(ctx context.Context, current *Token) (*Token, error) {
+			return nil, errors.New("token endpoint down")
+		},
+		nil,
+	)
+
+	token, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Expected cached token while still valid, got error %v", err)
+	}
+	if token.AccessToken != "current" {
+		t.Errorf("Expected 'current', got %q", token.AccessToken)
+	}
+
+	if _, err := ts.Refresh(context.Background()); err == nil {
+		t.Error("Expected forced refresh to return the error")
+	}
+}
+
+func TestClientRefreshesOnAuthError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprintln(w, `{"message": "expired"}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"id": "ok"}`)
+	}))
+	defer server.Close()
+
+	ts := NewRefreshingTokenSource(
+		&Token{AccessToken: "stale"},
+		func(ctx context.Context, current *Token) (*Token, error) {
+			return &Token{AccessToken: "fresh"}, nil
+		},
+		nil,
+	)
+	client, err := NewClientWithTokenSource(ts, &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		MaxRetries: 0,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Request(http.MethodGet, "items", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := ParseResponse(resp, &result); err != nil {
+		t.Fatalf("Expected success after refresh, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if client.GetAccessToken() != "fresh" {
+		t.Errorf("Expected GetAccessToken to return 'fresh', got %q", client.GetAccessToken())
+	}
+}
+
+func TestClientConcurrentAuthErrorsRefreshOnce(t *testing.T) {
+	const concurrent = 5
+	var rejected sync.WaitGroup
+	rejected.Add(concurrent)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			// Hold every 401 until all requests were sent with the stale token
+			rejected.Done()
+			rejected.Wait()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"id": "ok"}`)
+	}))
+	defer server.Close()
+
+	var refreshes int32
+	ts := NewRefreshingTokenSource(
+		&Token{AccessToken: "stale"},
+		func(ctx context.Context, current *Token) (*Token, error) {
+			atomic.AddInt32(&refreshes, 1)
+			return &Token{AccessToken: "fresh"}, nil
+		},
+		nil,
+	)
+	client, err := NewClientWithTokenSource(ts, &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+		MaxRetries: 0,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range concurrent {
+		wg.Go(func() {
+			resp, err := client.Request(http.MethodGet, "items", nil, nil)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected 200 after refresh, got %d", resp.StatusCode)
+			}
+		})
+	}
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Errorf("Expected 1 refresh, got %d", refreshes)
+	}
+}
+
+func TestClientStaticTokenAuthErrorNotRetried(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:    server.URL,
+		HttpClient: server.Client(),
+	})
+
+	resp, err := client.Request(http.MethodGet, "items", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401, got %d", resp.StatusCode)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+	if _, err := client.RefreshAccessToken(context.Background()); !errors.Is(err, ErrTokenNotRefreshable) {
+		t.Errorf("Expected ErrTokenNotRefreshable, got %v", err)
+	}
+}
+
+func TestNewClientWithTokenSource_Nil(t *testing.T) {
+	if _, err := NewClientWithTokenSource(nil, nil); err == nil {
+		t.Error("Expected error for nil token source")
+	}
+}
+
+func TestOAuthConfig_AuthCodeURL(t *testing.T) {
+	o := &OAuthConfig{
+		ClientID:    "client-id",
+		RedirectURI: "https://example.com/callback",
+		Scopes:      []string{"spark:messages_read", "spark:rooms_read"},
+	}
+
+	u, err := url.Parse(o.AuthCodeURL("state-123"))
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+	if u.Scheme+"://"+u.Host+u.Path != DefaultAuthorizeURL {
+		t.Errorf("Expected authorize endpoint, got %s", u.String())
+	}
+	q := u.Query()
+	if q.Get("client_id") != "client-id" || q.Get("response_type") != "code" || q.Get("state") != "state-123" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+	if q.Get("scope") != "spark:messages_read spark:rooms_read" {
+		t.Errorf("Expected space-separated scopes, got %q", q.Get("scope"))
+	}
+}
+
+func TestOAuthConfig_ExchangeAndRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			t.Errorf("Expected form content type, got %q", r.Header.Get("Content-Type"))
+		}
+		_ = r.ParseForm()
+		if r.Form.Get("client_secret") != "secret" {
+			t.Errorf("Expected client_secret, got %q", r.Form.Get("client_secret"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			if r.Form.Get("code") != "auth-code" {
+				t.Errorf("Expected code 'auth-code', got %q", r.Form.Get("code"))
+			}
+			_, _ = fmt.Fprintln(w, `{"access_token":"access-1","expires_in":1209600,"refresh_token":"refresh-1","refresh_token_expires_in":7776000}`)
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh-1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = fmt.Fprintln(w, `{"message":"invalid refresh token","trackingId":"track-1"}`)
+				return
+			}
+			_, _ = fmt.Fprintln(w, `{"access_token":"access-2","expires_in":1209600,"refresh_token":"refresh-1","refresh_token_expires_in":7776000}`)
+		default:
+			t.Errorf("Unexpected grant_type %q", r.Form.Get("grant_type"))
+		}
+	}))
+	defer server.Close()
+
+	o := &OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "secret",
+		RedirectURI:  "https://example.com/callback",
+		TokenURL:     server.URL,
+		HttpClient:   server.Client(),
+	}
+
+	token, err := o.Exchange(context.Background(), "auth-code")
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+	if time.Until(token.Expiry) < 13*24*time.Hour {
+		t.Errorf("Expected expiry ~14 days out, got %v", token.Expiry)
+	}
+
+	ts := o.TokenSource(token, nil)
+	refreshed, err := ts.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if refreshed.AccessToken != "access-2" {
+		t.Errorf("Expected 'access-2', got %q", refreshed.AccessToken)
+	}
+
+	_, err = o.Refresh(context.Background(), "bad")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.TrackingID != "track-1" {
+		t.Errorf("Expected APIError with trackingId, got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// TrackingIDHeader is the header carrying the Webex tracking identifier.
+// Webex support uses it to locate a request in their logs, and the API
+// echoes it back on the response.
+const TrackingIDHeader = "TrackingID"
+
+type trackingIDKey struct{}
+
+type responseMetadataKey struct{}
+
+// WithTrackingID returns a context that makes requests sent with it use id
+// as their TrackingID instead of a generated one. Retries of a request reuse
+// the same ID.
+func WithTrackingID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, trackingIDKey{}, id)
+}
+
+// TrackingIDFromContext returns the tracking ID set with WithTrackingID,
+// or "" if there is none.
+func TrackingIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(trackingIDKey{}).(string)
+	return id
+}
+
+// TrackingID returns the tracking ID to send with a request made with ctx:
+// the one set with WithTrackingID, or a new one from Config.TrackingIDGenerator.
+func (c *Client) TrackingID(ctx context.Context) string {
+	if id := TrackingIDFromContext(ctx); id != "" {
+		return id
+	}
+	if c.Config.TrackingIDGenerator != nil {
+		return c.Config.TrackingIDGenerator()
+	}
+	return "webex-go-sdk_" + uuid.New().String()
+}
+
+// setTrackingID sets the TrackingID header on req unless it already has one.
+func (c *Client) setTrackingID(req *http.Request) {
+	if req.Header.Get(TrackingIDHeader) == "" {
+		req.Header.Set(TrackingIDHeader, c.TrackingID(req.Context()))
+	}
+}
+
+// ResponseMetadata describes the HTTP exchange behind an API call. Most
+// methods in the SDK return decoded resources rather than the response, so
+// the metadata is captured through the context with WithResponseMetadata:
+//
+//	var md webexsdk.ResponseMetadata
+//	room, err := client.Rooms().GetCtx(webexsdk.WithResponseMetadata(ctx, &md), roomID)
+//	log.Printf("trackingId=%s status=%d", md.TrackingID, md.StatusCode)
+type ResponseMetadata struct {
+	// StatusCode is the HTTP status code of the final response, or 0 if no
+	// response was received.
+	StatusCode int
+
+	// TrackingID is the tracking ID echoed by the API, or the one sent with
+	// the request if the response did not carry one.
+	TrackingID string
+
+	// Header is the header of the final response.
+	Header http.Header
+}
+
+// WithResponseMetadata returns a context that makes requests sent with it
+// record their metadata in md. If a request is retried, md describes the
+// last attempt.
+func WithResponseMetadata(ctx context.Context, md *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, md)
+}
+
+// NewResponseMetadata returns the metadata of resp.
+func NewResponseMetadata(resp *http.Response) ResponseMetadata {
+	return ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		TrackingID: responseTrackingID(resp),
+		Header:     resp.Header,
+	}
+}
+
+// recordResponseMetadata stores the outcome of sending req in the
+// ResponseMetadata attached to its context, if any.
+func recordResponseMetadata(req *http.Request, resp *http.Response) {
+	md, ok := req.Context().Value(responseMetadataKey{}).(*ResponseMetadata)
+	if !ok || md == nil {
+		return
+	}
+	if resp == nil {
+		*md = ResponseMetadata{TrackingID: req.Header.Get(TrackingIDHeader)}
+		return
+	}
+	*md = NewResponseMetadata(resp)
+}
+
+// responseTrackingID returns the tracking ID echoed on resp, falling back
+// to the one sent with its request.
+func responseTrackingID(resp *http.Response) string {
+	if id := resp.Header.Get(TrackingIDHeader); id != "" {
+		return id
+	}
+	if resp.Request != nil {
+		return resp.Request.Header.Get(TrackingIDHeader)
+	}
+	return ""
+}
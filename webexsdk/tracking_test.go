@@ -0,0 +1,165 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webexsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrackingID_GeneratedAndSharedAcrossRetries(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get(TrackingIDHeader))
+		if len(seen) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:        server.URL,
+		HttpClient:     server.Client(),
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+	})
+
+	resp, err := client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(seen) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(seen))
+	}
+	if !strings.HasPrefix(seen[0], "webex-go-sdk_") {
+		t.Errorf("Expected generated tracking ID, got %q", seen[0])
+	}
+	if seen[0] != seen[1] {
+		t.Errorf("Expected retries to reuse the tracking ID, got %q and %q", seen[0], seen[1])
+	}
+
+	// Separate requests get separate IDs
+	resp, err = client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if seen[2] == seen[0] {
+		t.Errorf("Expected a new tracking ID per request, got %q twice", seen[0])
+	}
+}
+
+func TestTrackingID_Override(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(TrackingIDHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{
+		BaseURL:             server.URL,
+		HttpClient:          server.Client(),
+		TrackingIDGenerator: func() string { return "billing_42" },
+	})
+
+	resp, err := client.Request(http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if got != "billing_42" {
+		t.Errorf("Expected generator tracking ID, got %q", got)
+	}
+
+	ctx := WithTrackingID(context.Background(), "ticket-1234")
+	resp, err = client.RequestWithRetry(ctx, http.MethodGet, "rooms", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if got != "ticket-1234" {
+		t.Errorf("Expected context tracking ID, got %q", got)
+	}
+
+	// Do keeps a tracking ID set by the caller
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/rooms", nil)
+	req.Header.Set(TrackingIDHeader, "manual")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if got != "manual" {
+		t.Errorf("Expected caller tracking ID, got %q", got)
+	}
+}
+
+func TestTrackingID_ResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(TrackingIDHeader, r.Header.Get(TrackingIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"room-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{BaseURL: server.URL, HttpClient: server.Client()})
+
+	var md ResponseMetadata
+	ctx := WithResponseMetadata(WithTrackingID(context.Background(), "ROUTER_md"), &md)
+	resp, err := client.RequestWithRetry(ctx, http.MethodGet, "rooms/room-1", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var room struct{ ID string }
+	if err := ParseResponse(resp, &room); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if md.StatusCode != http.StatusOK || md.TrackingID != "ROUTER_md" {
+		t.Errorf("Unexpected metadata: %+v", md)
+	}
+	if md.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected response header in metadata, got %v", md.Header)
+	}
+}
+
+func TestTrackingID_OnAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Error body without a trackingId field
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{BaseURL: server.URL, HttpClient: server.Client()})
+
+	ctx := WithTrackingID(context.Background(), "ROUTER_err")
+	resp, err := client.RequestWithRetry(ctx, http.MethodGet, "rooms/missing", nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	err = ParseResponse(resp, &struct{}{})
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected NotFoundError, got %T", err)
+	}
+	if notFound.TrackingID != "ROUTER_err" {
+		t.Errorf("Expected tracking ID from request, got %q", notFound.TrackingID)
+	}
+}
@@ -13,10 +13,10 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
 	"time"
 )
 
@@ -40,8 +40,8 @@ type Client struct {
 	// Base URL for API requests
 	BaseURL *url.URL
 
-	// Access token for API authentication
-	accessToken string
+	// Source of access tokens for API authentication
+	tokenSource TokenSource
 
 	// Plugins registered with the client
 	plugins map[string]Plugin
@@ -51,11 +51,71 @@ type Client struct {
 
 	// Logger for SDK operations
 	logger Logger
+
+	// Structured logger for SDK operations
+	slog *slog.Logger
+
+	// Middleware chain ending in httpClient.Do
+	transport http.RoundTripper
 }
 
-// GetAccessToken returns the access token used for API authentication
+// GetAccessToken returns the current access token used for API authentication.
+// With a refreshing TokenSource the token is renewed first if it is about to
+// expire. If no token can be obtained the error is logged and "" is returned;
+// use AccessToken to handle the error directly.
 func (c *Client) GetAccessToken() string {
-	return c.accessToken
+	token, err := c.AccessToken(context.Background())
+	if err != nil {
+		c.slog.Error("failed to obtain access token", "error", err)
+	}
+	return token
+}
+
+// AccessToken returns the current access token from the client's TokenSource.
+func (c *Client) AccessToken(ctx context.Context) (string, error) {
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// RefreshAccessToken forces the client's TokenSource to obtain a new token and
+// returns the new access token. It returns ErrTokenNotRefreshable if the
+// TokenSource does not implement TokenRefresher.
+func (c *Client) RefreshAccessToken(ctx context.Context) (string, error) {
+	refresher, ok := c.tokenSource.(TokenRefresher)
+	if !ok {
+		return "", ErrTokenNotRefreshable
+	}
+	token, err := refresher.Refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// refreshRejectedToken refreshes the token after the API answered resp with
+// 401. If the TokenSource supports RefreshIfCurrent, the token the request
+// was sent with is passed along so that a token already replaced by a
+// concurrent request is not refreshed again.
+func (c *Client) refreshRejectedToken(ctx context.Context, resp *http.Response) error {
+	type currentRefresher interface {
+		RefreshIfCurrent(ctx context.Context, stale string) (*Token, error)
+	}
+	refresher, ok := c.tokenSource.(currentRefresher)
+	if !ok || resp.Request == nil {
+		_, err := c.RefreshAccessToken(ctx)
+		return err
+	}
+	stale := strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+	_, err := refresher.RefreshIfCurrent(ctx, stale)
+	return err
+}
+
+// GetTokenSource returns the TokenSource used for API authentication
+func (c *Client) GetTokenSource() TokenSource {
+	return c.tokenSource
 }
 
 // GetHTTPClient returns the HTTP client used for API requests
@@ -91,15 +151,71 @@ type Config struct {
 	// Subsequent retries use exponential backoff (delay * 2^attempt).
 	RetryBaseDelay time.Duration
 
+	// RetryPolicy controls jitter, transport error retries and retries of
+	// non-idempotent methods. If nil, the default policy is used. See
+	// RetryPolicy.
+	RetryPolicy *RetryPolicy
+
 	// Logger is the logger for SDK operations. If nil, the standard library's
 	// default logger (log.Default()) is used.
 	Logger Logger
+
+	// Slog is the structured logger for SDK operations and takes precedence
+	// over Logger. If nil and Logger is set, records of Info level and above
+	// are formatted as text and passed to Logger.Printf; if both are nil,
+	// slog.Default() is used.
+	Slog *slog.Logger
+
+	// RateLimiter throttles outgoing requests per resource and pauses a
+	// resource for all goroutines when it is rate limited (429). It may be
+	// shared between clients. If nil, requests are not throttled.
+	RateLimiter *RateLimiter
+
+	// CircuitBreaker rejects requests to endpoints that keep failing with
+	// ErrCircuitOpen instead of sending them. It applies to every request,
+	// including those of the calling and device packages, and may be
+	// shared between clients. If nil, requests are never rejected.
+	CircuitBreaker *CircuitBreaker
+
+	// Cache answers repeated GET requests to the API host from stored
+	// responses, revalidating them with ETags, and is invalidated by
+	// mutating requests. It may be shared between clients. If nil,
+	// responses are not cached. See Cache.
+	Cache *Cache
+
+	// Middleware wraps every request sent by the client, including those of
+	// the calling and device packages, in order: the first entry sees the
+	// request first. See Middleware.
+	Middleware []Middleware
+
+	// Tracer and Meter receive spans and metrics for REST calls and for the
+	// mercury, encryption and calling packages. See Tracer for adapting
+	// OpenTelemetry. If nil, nothing is recorded.
+	Tracer Tracer
+	Meter  Meter
+
+	// Cassette, if set, records requests to a file or replays them from
+	// it instead of using the network. It sees requests after all
+	// Middleware. See Cassette.
+	Cassette *Cassette
+
+	// Environment selects the Webex deployment (Commercial, FedRAMP or a
+	// custom one). It supplies BaseURL if that is empty and the endpoints
+	// of the device, mercury, calling and encryption packages, and every
+	// request to a host outside it fails with a *HostNotAllowedError. If
+	// nil, the Commercial endpoints are used and hosts are not checked.
+	Environment *Environment
+
+	// TrackingIDGenerator returns the TrackingID sent with requests that do
+	// not set one through WithTrackingID. If nil, IDs of the form
+	// "webex-go-sdk_<uuid>" are generated.
+	TrackingIDGenerator func() string
 }
 
 // DefaultConfig returns a default configuration for the Webex client
 func DefaultConfig() *Config {
 	return &Config{
-		BaseURL:        "https://webexapis.com/v1",
+		BaseURL:        Commercial.APIBaseURL,
 		Timeout:        30 * time.Second,
 		DefaultHeaders: make(map[string]string),
 		HttpClient:     nil,
@@ -115,10 +231,26 @@ func NewClient(accessToken string, config *Config) (*Client, error) {
 		return nil, fmt.Errorf("access token cannot be empty")
 	}
 
+	return NewClientWithTokenSource(StaticTokenSource(accessToken), config)
+}
+
+// NewClientWithTokenSource creates a new Webex client that obtains access tokens
+// from tokenSource, for example an OAuthConfig.TokenSource for Integrations.
+// If tokenSource implements TokenRefresher, a request rejected with 401 is
+// retried once after a forced refresh.
+// If config is nil, the default configuration will be used.
+func NewClientWithTokenSource(tokenSource TokenSource, config *Config) (*Client, error) {
+	if tokenSource == nil {
+		return nil, fmt.Errorf("token source cannot be nil")
+	}
+
 	if config == nil {
 		config = DefaultConfig()
 	} else {
 		// Validate BaseURL
+		if config.BaseURL == "" && config.Environment != nil {
+			config.BaseURL = config.Environment.APIBaseURL
+		}
 		if config.BaseURL == "" {
 			config.BaseURL = DefaultConfig().BaseURL
 		}
@@ -132,6 +264,11 @@ func NewClient(accessToken string, config *Config) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if config.Environment != nil {
+		if err := config.Environment.CheckURL(config.BaseURL); err != nil {
+			return nil, fmt.Errorf("invalid BaseURL: %w", err)
+		}
+	}
 
 	// Create HTTP client - either use the provided custom client or create a default one
 	httpClient := config.HttpClient
@@ -141,20 +278,37 @@ func NewClient(accessToken string, config *Config) (*Client, error) {
 		}
 	}
 
-	// Set up logger - use provided logger or default
+	// Set up loggers - use provided loggers or defaults
 	logger := config.Logger
+	structured := config.Slog
+	switch {
+	case structured == nil && logger != nil:
+		structured = newPrintfSlog(logger)
+	case structured == nil:
+		structured = slog.Default()
+	}
 	if logger == nil {
-		logger = log.Default()
+		if config.Slog != nil {
+			logger = slogPrintfLogger{logger: config.Slog}
+		} else {
+			logger = log.Default()
+		}
 	}
 
 	client := &Client{
 		httpClient:  httpClient,
 		BaseURL:     baseURL,
-		accessToken: accessToken,
+		tokenSource: tokenSource,
 		plugins:     make(map[string]Plugin),
 		logger:      logger,
+		slog:        structured,
 		Config:      config,
 	}
+	var transport http.RoundTripper = RoundTripperFunc(httpClient.Do)
+	if config.Cassette != nil {
+		transport = config.Cassette.Middleware()(transport)
+	}
+	client.transport = chainMiddleware(transport, config.Middleware)
 
 	return client, nil
 }
@@ -204,7 +358,9 @@ func (c *Client) RequestWithContext(ctx context.Context, method, path string, pa
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if err := c.setAuthorization(req); err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
 
 	// Add default headers
@@ -212,7 +368,7 @@ func (c *Client) RequestWithContext(ctx context.Context, method, path string, pa
 		req.Header.Set(k, v)
 	}
 
-	return c.httpClient.Do(req)
+	return c.send(req)
 }
 
 // RequestWithRetry performs an HTTP request with automatic retry for transient errors.
@@ -220,45 +376,229 @@ func (c *Client) RequestWithContext(ctx context.Context, method, path string, pa
 // transient server errors (502, 503, 504) using exponential backoff.
 // The caller is responsible for closing the response body when done.
 func (c *Client) RequestWithRetry(ctx context.Context, method, path string, params url.Values, body interface{}) (*http.Response, error) {
+	return c.doWithRetry(ctx, method, c.BaseURL.String()+"/"+path, func(ctx context.Context) (*http.Response, error) {
+		return c.RequestWithContext(ctx, method, path, params, body)
+	})
+}
+
+// doWithRetry calls do until it returns a non-retryable response or the retry
+// budget is spent. A 401 response is retried once, without counting against
+// MaxRetries, if the TokenSource can be refreshed. The whole exchange,
+// including retries, is recorded as one SpanRequest span; do receives the
+// span's context so middleware can propagate it.
+func (c *Client) doWithRetry(ctx context.Context, method, target string, do func(ctx context.Context) (*http.Response, error)) (*http.Response, error) {
+	resource := Resource("")
+	if u, err := url.Parse(target); err == nil {
+		resource = resourceFromURL(u, c.BaseURL.Path)
+	}
+	attrs := []Attribute{Attr(AttrHTTPMethod, method), Attr(AttrResource, string(resource))}
+
+	// Fix the tracking ID up front so that all attempts share it
+	trackingID := c.TrackingID(ctx)
+	ctx = WithTrackingID(ctx, trackingID)
+
+	start := time.Now()
+	ctx, span := c.Tracer().Start(ctx, SpanRequest, append(attrs, Attr(AttrTrackingID, trackingID))...)
+	retries := 0
+	resp, err := c.retryLoop(ctx, method, do, &retries)
+
+	attrs = append(attrs, Attr(AttrRetryAttempts, retries))
+	if resp != nil {
+		attrs = append(attrs, Attr(AttrHTTPStatusCode, resp.StatusCode))
+		if id := responseTrackingID(resp); id != "" {
+			trackingID = id
+			span.SetAttributes(Attr(AttrTrackingID, trackingID))
+		}
+	}
+	span.SetAttributes(attrs[2:]...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+	c.Meter().RecordDuration(ctx, MetricRequestDuration, time.Since(start), attrs...)
+
+	logAttrs := []any{"method", method, "resource", string(resource), "retries", retries, "duration", time.Since(start), "trackingId", trackingID}
+	if resp != nil {
+		logAttrs = append(logAttrs, "status", resp.StatusCode)
+	}
+	if err != nil {
+		c.slog.DebugContext(ctx, "webex request failed", append(logAttrs, "error", err)...)
+	} else {
+		c.slog.DebugContext(ctx, "webex request", logAttrs...)
+	}
+
+	return resp, err
+}
+
+// retryLoop implements doWithRetry, counting retries in *retries.
+func (c *Client) retryLoop(ctx context.Context, method string, do func(ctx context.Context) (*http.Response, error), retries *int) (*http.Response, error) {
+	if ctx.Value(noRetryKey{}) != nil {
+		return do(ctx)
+	}
+
 	maxRetries := c.Config.MaxRetries
 	baseDelay := c.Config.RetryBaseDelay
 	if baseDelay == 0 {
 		baseDelay = 1 * time.Second
 	}
+	policy := c.retryPolicy()
+	replayable := isIdempotent(method) || policy.RetryNonIdempotent || IdempotencyKeyFromContext(ctx) != ""
 
 	var resp *http.Response
 	var err error
+	refreshed := false
 
 	for attempt := 0; attempt <= maxRetries; attempt++ {
-		resp, err = c.RequestWithContext(ctx, method, path, params, body)
+		resp, err = do(ctx)
 		if err != nil {
-			return nil, err
+			// Retry transient transport errors
+			if attempt == maxRetries || ctx.Err() != nil || !policy.retryableError(err, replayable) {
+				return nil, err
+			}
+			delay := policy.backoff(baseDelay, attempt)
+			c.slog.DebugContext(ctx, "retrying webex request after transport error",
+				"attempt", attempt+1, "delay", delay, "trackingId", TrackingIDFromContext(ctx), "error", err)
+			if err := sleepCtx(ctx, delay); err != nil {
+				return nil, err
+			}
+			*retries++
+			continue
 		}
 
-		// Check if we should retry
+		// Refresh the token and retry once on 401
+		if resp.StatusCode == http.StatusUnauthorized && !refreshed {
+			refreshed = true
+			if refreshErr := c.refreshRejectedToken(ctx, resp); refreshErr == nil {
+				_ = resp.Body.Close()
+				attempt--
+				continue
+			}
+		}
+
+		// Check if we should retry. 429 and 423 mean the request was not
+		// processed, so they are safe to retry for any method.
 		if !isRetryableStatus(resp.StatusCode) || attempt == maxRetries {
 			return resp, nil
 		}
+		if !replayable && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusLocked {
+			return resp, nil
+		}
 
-		// Determine delay
-		delay := retryDelay(resp, baseDelay, attempt)
+		// Determine delay, preferring the server's Retry-After
+		delay := policy.backoff(baseDelay, attempt)
+		if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			delay = ra
+		}
+		c.slog.DebugContext(ctx, "retrying webex request",
+			"status", resp.StatusCode, "attempt", attempt+1, "delay", delay,
+			"trackingId", responseTrackingID(resp))
 
 		// Close the response body before retrying
 		_ = resp.Body.Close()
 
 		// Wait with context cancellation support
-		timer := time.NewTimer(delay)
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return nil, ctx.Err()
-		case <-timer.C:
+		if err := sleepCtx(ctx, delay); err != nil {
+			return nil, err
 		}
+		*retries++
 	}
 
 	return resp, err
 }
 
+// Do sends a fully built request through the client's CircuitBreaker,
+// middleware chain and, for requests to the API host, its RateLimiter.
+// Unlike the Request methods it does not set authentication or default
+// headers and does not retry; it only sets the TrackingID header if the
+// request has none. Packages that call other Webex services directly (e.g.
+// calling's Mobius requests) use it so that middleware sees all traffic.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	return c.send(req)
+}
+
+// send sends a single request through the middleware chain, waiting for
+// the RateLimiter first if one is configured and the request targets the
+// API host. A 429 response pauses the request's resource on the RateLimiter.
+// If a CircuitBreaker is configured, requests to an open circuit fail with
+// a *CircuitOpenError and the outcome of the others is recorded. If a
+// Cache is configured, GET requests to the API host may be answered from
+// it without being sent, and mutating requests invalidate it. Requests to
+// a host outside the configured Environment fail with a
+// *HostNotAllowedError without being sent. The request is given a
+// TrackingID and the context's idempotency key if it has none, and the
+// outcome is recorded in the context's ResponseMetadata.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if env := c.Config.Environment; env != nil && !env.Allows(req.URL.Host) {
+		return nil, &HostNotAllowedError{Host: req.URL.Hostname(), Environment: env.Name}
+	}
+	c.setTrackingID(req)
+	if key := IdempotencyKeyFromContext(req.Context()); key != "" && req.Header.Get(IdempotencyKeyHeader) == "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	resp, err := c.cached(req)
+	recordResponseMetadata(req, resp)
+	return resp, err
+}
+
+// cached implements send.
+func (c *Client) cached(req *http.Request) (*http.Response, error) {
+	cache := c.Config.Cache
+	if cache == nil || req.URL.Host != c.BaseURL.Host {
+		return c.breaker(req)
+	}
+	return cache.roundTrip(req, resourceFromURL(req.URL, c.BaseURL.Path), c.breaker)
+}
+
+// breaker implements send.
+func (c *Client) breaker(req *http.Request) (*http.Response, error) {
+	cb := c.Config.CircuitBreaker
+	if cb == nil {
+		return c.limit(req)
+	}
+
+	done, err := cb.Allow(c.circuitKey(req))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.limit(req)
+	done(resp, err)
+	return resp, err
+}
+
+// limit implements send.
+func (c *Client) limit(req *http.Request) (*http.Response, error) {
+	limiter := c.Config.RateLimiter
+	if limiter == nil || req.URL.Host != c.BaseURL.Host {
+		return c.transport.RoundTrip(req)
+	}
+
+	resource := resourceFromURL(req.URL, c.BaseURL.Path)
+	release, err := limiter.Wait(req.Context(), resource)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, err := c.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		limiter.Pause(resource, retryDelay(resp, limiter.config.DefaultPause, 0))
+	}
+	return resp, nil
+}
+
+// setAuthorization sets the bearer Authorization header from the TokenSource.
+func (c *Client) setAuthorization(req *http.Request) error {
+	token, err := c.AccessToken(req.Context())
+	if err != nil {
+		return fmt.Errorf("error obtaining access token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
 // isRetryableStatus returns true for HTTP status codes that should be retried.
 // Includes 423 Locked (file being scanned for malware) per Webex API spec.
 func isRetryableStatus(statusCode int) bool {
@@ -269,17 +609,12 @@ func isRetryableStatus(statusCode int) bool {
 		statusCode == http.StatusGatewayTimeout
 }
 
-// retryDelay calculates the delay before the next retry attempt.
-// For 429 responses, it respects the Retry-After header if present.
-// Otherwise, it uses exponential backoff: baseDelay * 2^attempt.
+// retryDelay calculates the delay before the next attempt without jitter.
+// It respects the Retry-After header if present, in seconds or as an HTTP
+// date. Otherwise, it uses exponential backoff: baseDelay * 2^attempt.
 func retryDelay(resp *http.Response, baseDelay time.Duration, attempt int) time.Duration {
-	// Respect Retry-After header for 429 (rate limit) and 423 (malware scanning)
-	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusLocked {
-		if ra := resp.Header.Get("Retry-After"); ra != "" {
-			if seconds, err := strconv.Atoi(ra); err == nil && seconds > 0 {
-				return time.Duration(seconds) * time.Second
-			}
-		}
+	if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return ra
 	}
 	// Exponential backoff
 	return baseDelay * (1 << uint(attempt))
@@ -292,10 +627,32 @@ type MultipartField struct {
 }
 
 // MultipartFile represents a file to upload in a multipart request.
+// The content comes from Open, Reader or Content, in that order of
+// precedence, and is streamed to the server without being buffered.
 type MultipartFile struct {
 	FieldName string // Form field name (e.g., "files")
 	FileName  string // Original filename (e.g., "report.pdf")
 	Content   []byte // Raw file bytes
+
+	// Reader streams the file content. A Reader can be read only once, so
+	// a request with a Reader file is sent once and never retried; use
+	// Open for uploads that should be retried.
+	Reader io.Reader
+
+	// Open returns a new reader for the file content. It is called for
+	// every attempt, so the upload can be retried, and the reader is
+	// closed once it has been sent.
+	Open func() (io.ReadCloser, error)
+
+	// Size is the length of the Reader or Open content in bytes, or zero
+	// or negative if unknown. If the sizes of all files are known the
+	// request is sent with a Content-Length; otherwise it is chunked.
+	Size int64
+
+	// Progress, if set, is called as the file content is sent with the
+	// number of bytes sent so far and the total size (-1 if unknown). It
+	// starts again from zero if the upload is retried.
+	Progress func(sent, total int64)
 }
 
 // RequestMultipart performs a multipart/form-data POST request to the Webex API
@@ -307,40 +664,18 @@ func (c *Client) RequestMultipart(path string, fields []MultipartField, files []
 }
 
 // RequestMultipartWithRetry performs a multipart/form-data POST with retry support.
-// The multipart body is rebuilt on each retry attempt.
+// The multipart body is rebuilt on each retry attempt. Requests with a
+// file that has a Reader but no Open are not retried.
 func (c *Client) RequestMultipartWithRetry(ctx context.Context, path string, fields []MultipartField, files []MultipartFile) (*http.Response, error) {
-	maxRetries := c.Config.MaxRetries
-	baseDelay := c.Config.RetryBaseDelay
-	if baseDelay == 0 {
-		baseDelay = 1 * time.Second
-	}
-
-	var resp *http.Response
-	var err error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		resp, err = c.doMultipartRequest(ctx, path, fields, files)
-		if err != nil {
-			return nil, err
-		}
-
-		if !isRetryableStatus(resp.StatusCode) || attempt == maxRetries {
-			return resp, nil
-		}
-
-		delay := retryDelay(resp, baseDelay, attempt)
-		_ = resp.Body.Close()
-
-		timer := time.NewTimer(delay)
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return nil, ctx.Err()
-		case <-timer.C:
+	for _, f := range files {
+		if f.Open == nil && f.Reader != nil {
+			ctx = withoutRetry(ctx)
+			break
 		}
 	}
-
-	return resp, err
+	return c.doWithRetry(ctx, http.MethodPost, c.BaseURL.String()+"/"+path, func(ctx context.Context) (*http.Response, error) {
+		return c.doMultipartRequest(ctx, path, fields, files)
+	})
 }
 
 // doMultipartRequest performs a single multipart/form-data POST request.
@@ -350,45 +685,34 @@ func (c *Client) doMultipartRequest(ctx context.Context, path string, fields []M
 		return nil, err
 	}
 
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
-
-	// Write text fields
-	for _, f := range fields {
-		if err := writer.WriteField(f.Name, f.Value); err != nil {
-			return nil, fmt.Errorf("error writing field %s: %w", f.Name, err)
-		}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	// Write file parts
-	for _, f := range files {
-		part, err := writer.CreateFormFile(f.FieldName, f.FileName)
-		if err != nil {
-			return nil, fmt.Errorf("error creating form file %s: %w", f.FileName, err)
-		}
-		if _, err := part.Write(f.Content); err != nil {
-			return nil, fmt.Errorf("error writing file %s: %w", f.FileName, err)
-		}
+	if err := c.setAuthorization(req); err != nil {
+		return nil, err
 	}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("error closing multipart writer: %w", err)
+	// Add default headers
+	for k, v := range c.Config.DefaultHeaders {
+		req.Header.Set(k, v)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), &body)
+	body, contentType, length, err := newMultipartBody(fields, files)
 	if err != nil {
 		return nil, err
 	}
+	req.Body = body
+	req.ContentLength = length
+	req.Header.Set("Content-Type", contentType)
 
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// Add default headers
-	for k, v := range c.Config.DefaultHeaders {
-		req.Header.Set(k, v)
+	resp, err := c.send(req)
+	if err != nil {
+		// The body may not have reached the transport, which closes it
+		_ = body.Close()
 	}
-
-	return c.httpClient.Do(req)
+	return resp, err
 }
 
 // Resource is a typed string identifying a Webex API resource collection.
@@ -409,6 +733,11 @@ const (
 	ResourceEvents              Resource = "events"
 	ResourceRoomTabs            Resource = "room/tabs"
 	ResourceItems               Resource = "items"
+	ResourceAttachmentActions   Resource = "attachment/actions"
+	ResourceOrganizations       Resource = "organizations"
+	ResourceLicenses            Resource = "licenses"
+	ResourceRoles               Resource = "roles"
+	ResourceAdminAudit          Resource = "adminAudit"
 )
 
 // Page represents a paginated response from the Webex API.
@@ -450,38 +779,9 @@ func (c *Client) RequestURL(method, fullURL string, body interface{}) (*http.Res
 
 // RequestURLWithRetry performs an HTTP request to a full URL with retry logic.
 func (c *Client) RequestURLWithRetry(ctx context.Context, method, fullURL string, body interface{}) (*http.Response, error) {
-	maxRetries := c.Config.MaxRetries
-	baseDelay := c.Config.RetryBaseDelay
-	if baseDelay == 0 {
-		baseDelay = 1 * time.Second
-	}
-
-	var resp *http.Response
-	var err error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		resp, err = c.doRequestURL(ctx, method, fullURL, body)
-		if err != nil {
-			return nil, err
-		}
-
-		if !isRetryableStatus(resp.StatusCode) || attempt == maxRetries {
-			return resp, nil
-		}
-
-		delay := retryDelay(resp, baseDelay, attempt)
-		_ = resp.Body.Close()
-
-		timer := time.NewTimer(delay)
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return nil, ctx.Err()
-		case <-timer.C:
-		}
-	}
-
-	return resp, err
+	return c.doWithRetry(ctx, method, fullURL, func(ctx context.Context) (*http.Response, error) {
+		return c.doRequestURL(ctx, method, fullURL, body)
+	})
 }
 
 // doRequestURL performs a single HTTP request to a full URL.
@@ -500,14 +800,16 @@ func (c *Client) doRequestURL(ctx context.Context, method, fullURL string, body
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if err := c.setAuthorization(req); err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
 
 	for k, v := range c.Config.DefaultHeaders {
 		req.Header.Set(k, v)
 	}
 
-	return c.httpClient.Do(req)
+	return c.send(req)
 }
 
 // parseLinkHeader parses an RFC 5988 Link header value and returns a map
@@ -639,12 +941,17 @@ func NewPage(resp *http.Response, client *Client, resource Resource) (*Page, err
 
 // Next retrieves the next page of results using the URL from the Link header.
 func (p *Page) Next() (*Page, error) {
+	return p.NextCtx(context.Background())
+}
+
+// NextCtx is like Next but uses ctx for the request.
+func (p *Page) NextCtx(ctx context.Context) (*Page, error) {
 	if !p.HasNext {
 		return nil, fmt.Errorf("no next page")
 	}
 
-	// Link header URLs are absolute — use RequestURL
-	resp, err := p.Client.RequestURL(http.MethodGet, p.NextPage, nil)
+	// Link header URLs are absolute — use RequestURLWithRetry
+	resp, err := p.Client.RequestURLWithRetry(ctx, http.MethodGet, p.NextPage, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -654,11 +961,16 @@ func (p *Page) Next() (*Page, error) {
 
 // Prev retrieves the previous page of results using the URL from the Link header.
 func (p *Page) Prev() (*Page, error) {
+	return p.PrevCtx(context.Background())
+}
+
+// PrevCtx is like Prev but uses ctx for the request.
+func (p *Page) PrevCtx(ctx context.Context) (*Page, error) {
 	if !p.HasPrev {
 		return nil, fmt.Errorf("no previous page")
 	}
 
-	resp, err := p.Client.RequestURL(http.MethodGet, p.PrevPage, nil)
+	resp, err := p.Client.RequestURLWithRetry(ctx, http.MethodGet, p.PrevPage, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -678,11 +990,16 @@ func (p *Page) Prev() (*Page, error) {
 //	// Later — jump directly to that page:
 //	resumedPage, _ := client.PageFromCursor(cursor)
 func (c *Client) PageFromCursor(cursorURL string) (*Page, error) {
+	return c.PageFromCursorCtx(context.Background(), cursorURL)
+}
+
+// PageFromCursorCtx is like PageFromCursor but uses ctx for the request.
+func (c *Client) PageFromCursorCtx(ctx context.Context, cursorURL string) (*Page, error) {
 	if cursorURL == "" {
 		return nil, fmt.Errorf("cursor URL is empty")
 	}
 
-	resp, err := c.RequestURL(http.MethodGet, cursorURL, nil)
+	resp, err := c.RequestURLWithRetry(ctx, http.MethodGet, cursorURL, nil)
 	if err != nil {
 		return nil, err
 	}
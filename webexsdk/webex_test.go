@@ -8,11 +8,13 @@ package webexsdk
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 )
@@ -805,3 +807,60 @@ func TestRequestMultipart_Retries429(t *testing.T) {
 		t.Errorf("Expected 2 attempts, got %d", attempts)
 	}
 }
+
+func TestRequestMultipart_Streaming(t *testing.T) {
+	var gotLength int64
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLength = r.ContentLength
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", &Config{BaseURL: server.URL, HttpClient: server.Client()})
+	fields := []MultipartField{{Name: "roomId", Value: "room-1"}}
+
+	for _, tc := range []struct {
+		name       string
+		file       MultipartFile
+		wantLength bool
+	}{
+		{"known size", MultipartFile{FieldName: "files", FileName: "a.bin", Reader: strings.NewReader("0123456789"), Size: 10}, true},
+		{"unknown size", MultipartFile{FieldName: "files", FileName: "a.bin", Reader: strings.NewReader("0123456789")}, false},
+		{"open", MultipartFile{FieldName: "files", FileName: "a.bin", Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("0123456789")), nil
+		}, Size: 10}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var progress []int64
+			tc.file.Progress = func(sent, total int64) { progress = append(progress, sent) }
+
+			resp, err := client.RequestMultipart("messages", fields, []MultipartFile{tc.file})
+			if err != nil {
+				t.Fatalf("RequestMultipart failed: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if tc.wantLength && gotLength != int64(len(gotBody)) {
+				t.Errorf("Expected Content-Length %d, got %d", len(gotBody), gotLength)
+			}
+			if !tc.wantLength && gotLength != -1 {
+				t.Errorf("Expected chunked body, got Content-Length %d", gotLength)
+			}
+			if !strings.Contains(string(gotBody), "0123456789") {
+				t.Errorf("File content missing from body: %s", gotBody)
+			}
+			if len(progress) == 0 || progress[len(progress)-1] != 10 {
+				t.Errorf("Expected progress up to 10 bytes, got %v", progress)
+			}
+		})
+	}
+
+	openErr := errors.New("permission denied")
+	_, err := client.RequestMultipart("messages", fields, []MultipartFile{{FieldName: "files", FileName: "a.bin",
+		Open: func() (io.ReadCloser, error) { return nil, openErr }}})
+	if !errors.Is(err, openErr) {
+		t.Errorf("Expected open error, got %v", err)
+	}
+}
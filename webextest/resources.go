@@ -0,0 +1,646 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webextest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+// routes registers the handlers of the faked REST surface.
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /people", s.listPeople)
+	s.mux.HandleFunc("GET /people/me", s.getMe)
+	s.mux.HandleFunc("GET /people/{id}", s.getItem(webexsdk.ResourcePeople))
+
+	s.mux.HandleFunc("POST /rooms", s.createRoom)
+	s.mux.HandleFunc("GET /rooms", s.listItems(webexsdk.ResourceRooms, "teamId", "type"))
+	s.mux.HandleFunc("GET /rooms/{id}", s.getItem(webexsdk.ResourceRooms))
+	s.mux.HandleFunc("PUT /rooms/{id}", s.updateItem(webexsdk.ResourceRooms, "rooms", "title", "isLocked", "teamId"))
+	s.mux.HandleFunc("DELETE /rooms/{id}", s.deleteRoom)
+
+	s.mux.HandleFunc("POST /memberships", s.createMembership)
+	s.mux.HandleFunc("GET /memberships", s.listItems(webexsdk.ResourceMemberships, "roomId", "personId", "personEmail"))
+	s.mux.HandleFunc("GET /memberships/{id}", s.getItem(webexsdk.ResourceMemberships))
+	s.mux.HandleFunc("PUT /memberships/{id}", s.updateItem(webexsdk.ResourceMemberships, "memberships", "isModerator", "isRoomHidden"))
+	s.mux.HandleFunc("DELETE /memberships/{id}", s.deleteItem(webexsdk.ResourceMemberships, "memberships"))
+
+	s.mux.HandleFunc("POST /messages", s.createMessage)
+	s.mux.HandleFunc("GET /messages", s.listMessages)
+	s.mux.HandleFunc("GET /messages/{id}", s.getItem(webexsdk.ResourceMessages))
+	s.mux.HandleFunc("PUT /messages/{id}", s.updateMessage)
+	s.mux.HandleFunc("DELETE /messages/{id}", s.deleteItem(webexsdk.ResourceMessages, "messages"))
+
+	s.mux.HandleFunc("POST /teams", s.createItem(webexsdk.ResourceTeams, "name"))
+	s.mux.HandleFunc("GET /teams", s.listItems(webexsdk.ResourceTeams))
+	s.mux.HandleFunc("GET /teams/{id}", s.getItem(webexsdk.ResourceTeams))
+	s.mux.HandleFunc("PUT /teams/{id}", s.updateItem(webexsdk.ResourceTeams, "", "name", "description"))
+	s.mux.HandleFunc("DELETE /teams/{id}", s.deleteItem(webexsdk.ResourceTeams, ""))
+
+	s.mux.HandleFunc("POST /webhooks", s.createItem(webexsdk.ResourceWebhooks, "name", "targetUrl", "resource", "event"))
+	s.mux.HandleFunc("GET /webhooks", s.listItems(webexsdk.ResourceWebhooks))
+	s.mux.HandleFunc("GET /webhooks/{id}", s.getItem(webexsdk.ResourceWebhooks))
+	s.mux.HandleFunc("PUT /webhooks/{id}", s.updateItem(webexsdk.ResourceWebhooks, "", "name", "targetUrl", "secret", "status"))
+	s.mux.HandleFunc("DELETE /webhooks/{id}", s.deleteItem(webexsdk.ResourceWebhooks, ""))
+
+	s.mux.HandleFunc("GET /events", s.listEvents)
+	s.mux.HandleFunc("GET /events/{id}", s.getItem(webexsdk.ResourceEvents))
+
+	s.mux.HandleFunc("POST /attachment/actions", s.createAttachmentAction)
+	s.mux.HandleFunc("GET /attachment/actions/{id}", s.getItem(webexsdk.ResourceAttachmentActions))
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "The requested resource could not be found.")
+	})
+}
+
+// --- Generic handlers ---
+
+// getItem returns a handler that responds with the item named by the
+// request's {id}.
+func (s *Server) getItem(resource webexsdk.Resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		item := s.find(resource, r.PathValue("id"))
+		if item == nil {
+			writeNotFound(w, resource)
+			return
+		}
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+// listItems returns a handler that lists the items of resource, filtered
+// by equality on the given query parameters.
+func (s *Server) listItems(resource webexsdk.Resource, filters ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.writePage(w, r, s.filter(resource, func(item Item) bool {
+			return matches(item, r, filters...)
+		}))
+	}
+}
+
+// createItem returns a handler that stores the request body as a new item
+// of resource after checking the required fields.
+func (s *Server) createItem(resource webexsdk.Resource, required ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readBody(w, r, required...)
+		if !ok {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(body, "id")
+		body["creatorId"] = s.meID
+		if resource == webexsdk.ResourceWebhooks {
+			body["status"] = "active"
+			body["ownedBy"] = "creator"
+		}
+		s.insert(resource, body)
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+// updateItem returns a handler that copies the given fields of the request
+// body onto the item named by {id}. If eventResource is set, an "updated"
+// event is recorded.
+func (s *Server) updateItem(resource webexsdk.Resource, eventResource string, fields ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		item := s.find(resource, r.PathValue("id"))
+		if item == nil {
+			writeNotFound(w, resource)
+			return
+		}
+		for _, field := range fields {
+			if v, ok := body[field]; ok {
+				item[field] = v
+			}
+		}
+		if eventResource != "" {
+			s.recordEvent(eventResource, "updated", item)
+		}
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+// deleteItem returns a handler that deletes the item named by {id}. If
+// eventResource is set, a "deleted" event is recorded.
+func (s *Server) deleteItem(resource webexsdk.Resource, eventResource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		item := s.find(resource, r.PathValue("id"))
+		if item == nil {
+			writeNotFound(w, resource)
+			return
+		}
+		s.remove(resource, func(i Item) bool { return i["id"] == item["id"] })
+		if eventResource != "" {
+			s.recordEvent(eventResource, "deleted", item)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// --- People ---
+
+func (s *Server) getMe(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.find(webexsdk.ResourcePeople, s.meID))
+}
+
+func (s *Server) listPeople(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	ids := query["id"]
+	email := query.Get("email")
+	displayName := query.Get("displayName")
+	if len(ids) == 0 && email == "" && displayName == "" {
+		writeError(w, http.StatusBadRequest, "Email, displayName, or id list should be specified.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writePage(w, r, s.filter(webexsdk.ResourcePeople, func(item Item) bool {
+		if len(ids) > 0 && !slices.Contains(ids, str(item, "id")) {
+			return false
+		}
+		if email != "" && !slices.Contains(strs(item, "emails"), email) {
+			return false
+		}
+		return displayName == "" || strings.HasPrefix(strings.ToLower(str(item, "displayName")), strings.ToLower(displayName))
+	}))
+}
+
+// findPerson returns the person with the given ID or email, or nil.
+// The caller must hold s.mu.
+func (s *Server) findPerson(id, email string) Item {
+	for _, person := range s.collections[webexsdk.ResourcePeople] {
+		if (id != "" && person["id"] == id) || (email != "" && slices.Contains(strs(person, "emails"), email)) {
+			return person
+		}
+	}
+	return nil
+}
+
+// --- Rooms ---
+
+func (s *Server) createRoom(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r, "title")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(body, "id")
+	body["type"] = "group"
+	body["creatorId"] = s.meID
+	body["lastActivity"] = now()
+	if _, ok := body["isLocked"]; !ok {
+		body["isLocked"] = false
+	}
+	s.insert(webexsdk.ResourceRooms, body)
+	s.recordEvent("rooms", "created", body)
+	s.addMember(body, s.find(webexsdk.ResourcePeople, s.meID), false)
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) deleteRoom(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if s.find(webexsdk.ResourceRooms, id) == nil {
+		writeNotFound(w, webexsdk.ResourceRooms)
+		return
+	}
+	inRoom := func(item Item) bool { return item["roomId"] == id }
+	s.remove(webexsdk.ResourceRooms, func(item Item) bool { return item["id"] == id })
+	s.remove(webexsdk.ResourceMemberships, inRoom)
+	s.remove(webexsdk.ResourceMessages, inRoom)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// directRoom returns the direct room between the caller and person,
+// creating it if needed. The caller must hold s.mu.
+func (s *Server) directRoom(person Item) Item {
+	for _, room := range s.collections[webexsdk.ResourceRooms] {
+		if room["type"] == "direct" && room["directPersonId"] == person["id"] {
+			return room
+		}
+	}
+	room := Item{
+		"title":          str(person, "displayName"),
+		"type":           "direct",
+		"isLocked":       false,
+		"creatorId":      s.meID,
+		"lastActivity":   now(),
+		"directPersonId": person["id"],
+	}
+	s.insert(webexsdk.ResourceRooms, room)
+	s.addMember(room, s.find(webexsdk.ResourcePeople, s.meID), false)
+	s.addMember(room, person, false)
+	return room
+}
+
+// --- Memberships ---
+
+func (s *Server) createMembership(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r, "roomId")
+	if !ok {
+		return
+	}
+	personID, email := str(body, "personId"), str(body, "personEmail")
+	if personID == "" && email == "" {
+		writeError(w, http.StatusBadRequest, "personId or personEmail is required.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	room := s.find(webexsdk.ResourceRooms, str(body, "roomId"))
+	if room == nil {
+		writeNotFound(w, webexsdk.ResourceRooms)
+		return
+	}
+	person := s.findPerson(personID, email)
+	if person == nil {
+		if email == "" {
+			writeNotFound(w, webexsdk.ResourcePeople)
+			return
+		}
+		// Inviting an unknown email creates the person, as the API does
+		person = Item{"emails": []string{email}, "displayName": email, "type": "person"}
+		s.insert(webexsdk.ResourcePeople, person)
+	}
+	for _, m := range s.collections[webexsdk.ResourceMemberships] {
+		if m["roomId"] == room["id"] && m["personId"] == person["id"] {
+			writeError(w, http.StatusConflict, "Person is already in the room.")
+			return
+		}
+	}
+
+	isModerator, _ := body["isModerator"].(bool)
+	writeJSON(w, http.StatusOK, s.addMember(room, person, isModerator))
+}
+
+// addMember stores and returns a membership of person in room and records
+// its event. The caller must hold s.mu.
+func (s *Server) addMember(room, person Item, isModerator bool) Item {
+	membership := Item{
+		"roomId":            room["id"],
+		"roomType":          room["type"],
+		"personId":          person["id"],
+		"personDisplayName": person["displayName"],
+		"personOrgId":       person["orgId"],
+		"isModerator":       isModerator,
+		"isMonitor":         false,
+		"isRoomHidden":      false,
+	}
+	if emails := strs(person, "emails"); len(emails) > 0 {
+		membership["personEmail"] = emails[0]
+	}
+	s.insert(webexsdk.ResourceMemberships, membership)
+	s.recordEvent("memberships", "created", membership)
+	return membership
+}
+
+// --- Messages ---
+
+func (s *Server) createMessage(w http.ResponseWriter, r *http.Request) {
+	var body Item
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		var ok bool
+		if body, ok = s.readMultipartMessage(w, r); !ok {
+			return
+		}
+	} else {
+		var ok bool
+		if body, ok = readBody(w, r); !ok {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var room Item
+	switch {
+	case str(body, "roomId") != "":
+		room = s.find(webexsdk.ResourceRooms, str(body, "roomId"))
+		if room == nil {
+			writeError(w, http.StatusNotFound, "Could not find a room with provided ID.")
+			return
+		}
+	case str(body, "toPersonId") != "" || str(body, "toPersonEmail") != "":
+		person := s.findPerson(str(body, "toPersonId"), str(body, "toPersonEmail"))
+		if person == nil {
+			writeNotFound(w, webexsdk.ResourcePeople)
+			return
+		}
+		room = s.directRoom(person)
+	default:
+		writeError(w, http.StatusBadRequest, "roomId, toPersonId or toPersonEmail is required.")
+		return
+	}
+	if str(body, "parentId") != "" && s.find(webexsdk.ResourceMessages, str(body, "parentId")) == nil {
+		writeError(w, http.StatusBadRequest, "Invalid parentId.")
+		return
+	}
+
+	me := s.find(webexsdk.ResourcePeople, s.meID)
+	delete(body, "id")
+	body["roomId"] = room["id"]
+	body["roomType"] = room["type"]
+	body["personId"] = s.meID
+	if emails := strs(me, "emails"); len(emails) > 0 {
+		body["personEmail"] = emails[0]
+	}
+	if str(body, "text") == "" && str(body, "markdown") != "" {
+		body["text"] = body["markdown"]
+	}
+	s.insert(webexsdk.ResourceMessages, body)
+	room["lastActivity"] = body["created"]
+	s.recordEvent("messages", "created", body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+// readMultipartMessage reads a message created with a local file upload.
+// Uploaded files are listed by content URL.
+func (s *Server) readMultipartMessage(w http.ResponseWriter, r *http.Request) (Item, bool) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid multipart body.")
+		return nil, false
+	}
+	body := Item{}
+	for name, values := range r.MultipartForm.Value {
+		if len(values) > 0 {
+			body[name] = values[0]
+		}
+	}
+	var files []string
+	for _, headers := range r.MultipartForm.File {
+		for range headers {
+			files = append(files, "http://"+r.Host+"/v1/contents/"+newID("contents"))
+		}
+	}
+	if len(files) > 0 {
+		body["files"] = files
+	}
+	return body, true
+}
+
+func (s *Server) listMessages(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	roomID := query.Get("roomId")
+	if roomID == "" {
+		writeError(w, http.StatusBadRequest, "roomId is required.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.find(webexsdk.ResourceRooms, roomID) == nil {
+		writeError(w, http.StatusNotFound, "Could not find a room with provided ID.")
+		return
+	}
+
+	before := parseTime(query.Get("before"))
+	if id := query.Get("beforeMessage"); id != "" {
+		if m := s.find(webexsdk.ResourceMessages, id); m != nil {
+			before = parseTime(str(m, "created"))
+		}
+	}
+	parentID := query.Get("parentId")
+	if parentID == "" {
+		parentID = query.Get("threadId")
+	}
+	mentioned := query.Get("mentionedPeople")
+	if mentioned == "me" {
+		mentioned = s.meID
+	}
+
+	items := s.filter(webexsdk.ResourceMessages, func(item Item) bool {
+		if !matches(item, r, "roomId", "personId", "personEmail") {
+			return false
+		}
+		if parentID != "" && item["parentId"] != parentID {
+			return false
+		}
+		if mentioned != "" && !slices.Contains(strs(item, "mentionedPeople"), mentioned) {
+			return false
+		}
+		if query.Get("hasFiles") == "true" && len(strs(item, "files")) == 0 {
+			return false
+		}
+		return before.IsZero() || parseTime(str(item, "created")).Before(before)
+	})
+	slices.Reverse(items) // newest first
+	s.writePage(w, r, items)
+}
+
+func (s *Server) updateMessage(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r, "roomId")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.find(webexsdk.ResourceMessages, r.PathValue("id"))
+	if item == nil {
+		writeNotFound(w, webexsdk.ResourceMessages)
+		return
+	}
+	if item["roomId"] != body["roomId"] {
+		writeError(w, http.StatusBadRequest, "roomId does not match the message.")
+		return
+	}
+	for _, field := range []string{"text", "markdown", "html"} {
+		delete(item, field)
+		if v, ok := body[field]; ok {
+			item[field] = v
+		}
+	}
+	if str(item, "text") == "" && str(item, "markdown") != "" {
+		item["text"] = item["markdown"]
+	}
+	item["updated"] = now()
+	s.recordEvent("messages", "updated", item)
+	writeJSON(w, http.StatusOK, item)
+}
+
+// --- Events ---
+
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	from, to := parseTime(query.Get("from")), parseTime(query.Get("to"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.filter(webexsdk.ResourceEvents, func(item Item) bool {
+		if !matches(item, r, "resource", "type", "actorId") {
+			return false
+		}
+		created := parseTime(str(item, "created"))
+		return (from.IsZero() || !created.Before(from)) && (to.IsZero() || created.Before(to))
+	})
+	slices.Reverse(items) // newest first
+	s.writePage(w, r, items)
+}
+
+// recordEvent stores an event of the given resource and type for data,
+// acted on by the caller. The caller must hold s.mu.
+func (s *Server) recordEvent(resource, eventType string, data Item) {
+	s.insert(webexsdk.ResourceEvents, Item{
+		"resource": resource,
+		"type":     eventType,
+		"actorId":  s.meID,
+		"orgId":    s.find(webexsdk.ResourcePeople, s.meID)["orgId"],
+		"data":     clone(data),
+	})
+}
+
+// --- Attachment actions ---
+
+func (s *Server) createAttachmentAction(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r, "type", "messageId")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message := s.find(webexsdk.ResourceMessages, str(body, "messageId"))
+	if message == nil {
+		writeNotFound(w, webexsdk.ResourceMessages)
+		return
+	}
+	delete(body, "id")
+	body["personId"] = s.meID
+	body["roomId"] = message["roomId"]
+	s.insert(webexsdk.ResourceAttachmentActions, body)
+	s.recordEvent("attachmentActions", "created", body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+// --- Helpers ---
+
+// filter returns the items of resource for which keep returns true.
+// The caller must hold s.mu.
+func (s *Server) filter(resource webexsdk.Resource, keep func(Item) bool) []Item {
+	var items []Item
+	for _, item := range s.collections[resource] {
+		if keep(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// matches reports whether item has the value of each of the given query
+// parameters of r that are set.
+func matches(item Item, r *http.Request, params ...string) bool {
+	query := r.URL.Query()
+	for _, param := range params {
+		if v := query.Get(param); v != "" && str(item, param) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// readBody decodes the JSON request body and checks that the required
+// fields are set. It writes a 400 response and returns false otherwise.
+func readBody(w http.ResponseWriter, r *http.Request, required ...string) (Item, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Could not read the request body.")
+		return nil, false
+	}
+	body := Item{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "The request body is not valid JSON.")
+			return nil, false
+		}
+	}
+	for _, field := range required {
+		if v, ok := body[field]; !ok || v == "" {
+			writeError(w, http.StatusBadRequest, field+" is required.")
+			return nil, false
+		}
+	}
+	return body, true
+}
+
+// writeNotFound writes the 404 response for a missing item of resource.
+func writeNotFound(w http.ResponseWriter, resource webexsdk.Resource) {
+	writeError(w, http.StatusNotFound, "The requested "+string(resource)+" resource could not be found.")
+}
+
+// str returns the string field key of item, or "".
+func str(item Item, key string) string {
+	v, _ := item[key].(string)
+	return v
+}
+
+// strs returns the string list field key of item.
+func strs(item Item, key string) []string {
+	switch v := item[key].(type) {
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// parseTime parses an API timestamp, returning the zero time if s is
+// empty or invalid.
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
@@ -0,0 +1,444 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+// Package webextest provides an in-process fake of the Webex REST API for
+// tests. The fake keeps people, rooms, memberships, messages, teams,
+// webhooks, events and attachment actions in memory, so a client pointed at
+// it behaves as against the real API:
+//
+//	srv := webextest.NewServer(nil)
+//	defer srv.Close()
+//
+//	client, _ := webex.NewClient("test-token", &webexsdk.Config{BaseURL: srv.BaseURL()})
+//	room, _ := client.Rooms().Create(&rooms.Room{Title: "Standup"})
+package webextest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+	"github.com/google/uuid"
+)
+
+// Config holds the configuration for the fake server.
+type Config struct {
+	// Token, if set, is the only access token accepted; requests with any
+	// other token get 401. If empty, any bearer token is accepted.
+	Token string
+
+	// Me is the person the access token belongs to. It is returned by
+	// people/me and used as the author of created resources. If nil, a bot
+	// named "Test Bot" is used.
+	Me any
+
+	// DefaultMax is the page size of list requests without a max
+	// parameter. Default: 100.
+	DefaultMax int
+}
+
+// DefaultConfig returns the default configuration for the fake server.
+func DefaultConfig() *Config {
+	return &Config{
+		DefaultMax: 100,
+	}
+}
+
+// Item is a stored resource, as its JSON object.
+type Item = map[string]any
+
+// Server is a fake Webex REST API served over HTTP by an httptest.Server.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	config *Config
+	mux    *http.ServeMux
+	meID   string
+
+	mu          sync.Mutex
+	collections map[webexsdk.Resource][]Item
+	faults      []*Fault
+	requests    int
+}
+
+// kinds maps each resource to the type segment of its Hydra IDs.
+var kinds = map[webexsdk.Resource]string{
+	webexsdk.ResourcePeople:            "PEOPLE",
+	webexsdk.ResourceRooms:             "ROOM",
+	webexsdk.ResourceMemberships:       "MEMBERSHIP",
+	webexsdk.ResourceMessages:          "MESSAGE",
+	webexsdk.ResourceTeams:             "TEAM",
+	webexsdk.ResourceWebhooks:          "WEBHOOK",
+	webexsdk.ResourceEvents:            "EVENT",
+	webexsdk.ResourceAttachmentActions: "ATTACHMENT_ACTION",
+}
+
+// NewServer starts a fake server. If config is nil, the default
+// configuration will be used. The caller must call Close when done.
+func NewServer(config *Config) *Server {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if config.DefaultMax <= 0 {
+		config.DefaultMax = DefaultConfig().DefaultMax
+	}
+
+	s := &Server{
+		config:      config,
+		mux:         http.NewServeMux(),
+		collections: make(map[webexsdk.Resource][]Item),
+	}
+	s.routes()
+
+	me := config.Me
+	if me == nil {
+		me = Item{
+			"emails":      []string{"test-bot@webex.bot"},
+			"displayName": "Test Bot",
+			"type":        "bot",
+		}
+	}
+	s.meID = s.Seed(webexsdk.ResourcePeople, me)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the value to use as webexsdk.Config.BaseURL.
+func (s *Server) BaseURL() string {
+	return s.URL + "/v1"
+}
+
+// MeID returns the ID of the person the access token belongs to.
+func (s *Server) MeID() string {
+	return s.meID
+}
+
+// Seed stores item, which may be an SDK type such as rooms.Room or an
+// Item, under resource and returns its ID. An ID and creation time are
+// assigned if item does not have them. Seeding does not record events.
+func (s *Server) Seed(resource webexsdk.Resource, item any) string {
+	data, err := json.Marshal(item)
+	if err != nil {
+		panic(fmt.Sprintf("webextest: cannot encode seed item: %v", err))
+	}
+	var stored Item
+	if err := json.Unmarshal(data, &stored); err != nil {
+		panic(fmt.Sprintf("webextest: seed item is not a JSON object: %v", err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insert(resource, stored)
+}
+
+// Items returns a copy of the items stored under resource, in creation order.
+func (s *Server) Items(resource webexsdk.Resource) []Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]Item, 0, len(s.collections[resource]))
+	for _, item := range s.collections[resource] {
+		items = append(items, clone(item))
+	}
+	return items
+}
+
+// Get returns a copy of the item with the given ID stored under resource.
+func (s *Server) Get(resource webexsdk.Resource, id string) (Item, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := s.find(resource, id)
+	if item == nil {
+		return nil, false
+	}
+	return clone(item), true
+}
+
+// Requests returns the number of requests the server has received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Fault is an error response the server returns instead of handling a
+// matching request.
+type Fault struct {
+	// Method and Resource restrict the requests the fault applies to.
+	// Empty values match any request.
+	Method   string
+	Resource webexsdk.Resource
+
+	// Status is the HTTP status code to return. Default: 429.
+	Status int
+
+	// Message is the error message of the response body.
+	Message string
+
+	// RetryAfter is sent as the Retry-After header, in whole seconds,
+	// if non-zero.
+	RetryAfter time.Duration
+
+	// Times is the number of requests to fail. Default: 1.
+	Times int
+}
+
+// InjectFault makes the server fail the next matching requests with f.
+// Faults are matched in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	if f.Status == 0 {
+		f.Status = http.StatusTooManyRequests
+	}
+	if f.Times <= 0 {
+		f.Times = 1
+	}
+	if f.Message == "" {
+		f.Message = http.StatusText(f.Status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// InjectRateLimit makes the next times requests for resource fail with
+// 429 and the given Retry-After. An empty resource matches any request.
+func (s *Server) InjectRateLimit(resource webexsdk.Resource, times int, retryAfter time.Duration) {
+	s.InjectFault(Fault{
+		Resource:   resource,
+		Status:     http.StatusTooManyRequests,
+		Message:    "Too many requests have been sent in a given amount of time.",
+		RetryAfter: retryAfter,
+		Times:      times,
+	})
+}
+
+// serveHTTP authenticates the request, applies injected faults and
+// dispatches to the resource handlers.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	trackingID := r.Header.Get(webexsdk.TrackingIDHeader)
+	if trackingID == "" {
+		trackingID = "WEBEXTEST_" + uuid.New().String()
+	}
+	w.Header().Set(webexsdk.TrackingIDHeader, trackingID)
+
+	r.URL.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/v1"), "/")
+
+	s.mu.Lock()
+	s.requests++
+	s.mu.Unlock()
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "The request requires a valid access token set in the Authorization request header.")
+		return
+	}
+
+	s.mu.Lock()
+	fault := s.takeFault(r)
+	s.mu.Unlock()
+	if fault != nil {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(fault.RetryAfter.Seconds()))))
+		}
+		writeError(w, fault.Status, fault.Message)
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// authorized reports whether r carries an accepted bearer token.
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return false
+	}
+	return s.config.Token == "" || token == s.config.Token
+}
+
+// takeFault returns the first fault matching r and uses up one of its
+// times. The caller must hold s.mu.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	resource := resourceOf(r.URL.Path)
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Resource != "" && f.Resource != resource {
+			continue
+		}
+		f.Times--
+		if f.Times == 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		return f
+	}
+	return nil
+}
+
+// resourceOf returns the resource addressed by a request path.
+func resourceOf(path string) webexsdk.Resource {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 2 && segments[0]+"/"+segments[1] == string(webexsdk.ResourceAttachmentActions) {
+		return webexsdk.ResourceAttachmentActions
+	}
+	return webexsdk.Resource(segments[0])
+}
+
+// insert stores item under resource, assigning an ID and creation time if
+// missing, and returns its ID. The caller must hold s.mu.
+func (s *Server) insert(resource webexsdk.Resource, item Item) string {
+	id, _ := item["id"].(string)
+	if id == "" {
+		id = newID(resource)
+		item["id"] = id
+	}
+	// Zero times of non-pointer SDK fields count as unset
+	if created, _ := item["created"].(string); created == "" || created == "0001-01-01T00:00:00Z" {
+		item["created"] = now()
+	}
+	s.collections[resource] = append(s.collections[resource], item)
+	return id
+}
+
+// find returns the stored item with the given ID, or nil. The caller must
+// hold s.mu.
+func (s *Server) find(resource webexsdk.Resource, id string) Item {
+	for _, item := range s.collections[resource] {
+		if item["id"] == id {
+			return item
+		}
+	}
+	return nil
+}
+
+// remove deletes the items under resource for which drop returns true.
+// The caller must hold s.mu.
+func (s *Server) remove(resource webexsdk.Resource, drop func(Item) bool) {
+	kept := s.collections[resource][:0]
+	for _, item := range s.collections[resource] {
+		if !drop(item) {
+			kept = append(kept, item)
+		}
+	}
+	s.collections[resource] = kept
+}
+
+// newID returns a new Hydra-style ID for resource.
+func newID(resource webexsdk.Resource) string {
+	kind := kinds[resource]
+	if kind == "" {
+		kind = strings.ToUpper(string(resource))
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte("ciscospark://us/" + kind + "/" + uuid.New().String()))
+}
+
+// now returns the current time in the format used by the Webex API.
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+// clone returns a shallow copy of item.
+func clone(item Item) Item {
+	c := make(Item, len(item))
+	for k, v := range item {
+		c[k] = v
+	}
+	return c
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format parsed by
+// webexsdk.NewAPIError.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"message":    message,
+		"errors":     []map[string]string{{"description": message}},
+		"trackingId": w.Header().Get(webexsdk.TrackingIDHeader),
+	})
+}
+
+// writePage writes one page of items as a list response. The page is
+// selected by the max and cursor query parameters, and a Link header
+// points to the neighbouring pages.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, items []Item) {
+	query := r.URL.Query()
+
+	pageSize := s.config.DefaultMax
+	if v := query.Get("max"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, "Invalid max value.")
+			return
+		}
+		pageSize = n
+	}
+
+	offset := 0
+	if v := query.Get("cursor"); v != "" {
+		n, err := decodeCursor(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid cursor.")
+			return
+		}
+		offset = n
+	}
+
+	start := min(offset, len(items))
+	end := min(start+pageSize, len(items))
+
+	var links []string
+	if end < len(items) {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, s.pageURL(r, end)))
+	}
+	if start > 0 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, s.pageURL(r, max(start-pageSize, 0))))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	page := make([]Item, 0, end-start)
+	for _, item := range items[start:end] {
+		page = append(page, clone(item))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": page})
+}
+
+// pageURL returns the absolute URL of the page of r starting at offset.
+func (s *Server) pageURL(r *http.Request, offset int) string {
+	query := r.URL.Query()
+	query.Set("cursor", base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset))))
+	u := url.URL{Scheme: "http", Host: r.Host, Path: "/v1" + r.URL.Path, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// decodeCursor returns the offset encoded in a cursor query parameter.
+func decodeCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(data))
+}
@@ -0,0 +1,216 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webextest
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	webex "github.com/WebexCommunity/webex-go-sdk/v2"
+	"github.com/WebexCommunity/webex-go-sdk/v2/attachmentactions"
+	"github.com/WebexCommunity/webex-go-sdk/v2/events"
+	"github.com/WebexCommunity/webex-go-sdk/v2/memberships"
+	"github.com/WebexCommunity/webex-go-sdk/v2/messages"
+	"github.com/WebexCommunity/webex-go-sdk/v2/people"
+	"github.com/WebexCommunity/webex-go-sdk/v2/rooms"
+	"github.com/WebexCommunity/webex-go-sdk/v2/webexsdk"
+)
+
+func newTestClient(t *testing.T, srv *Server) *webex.WebexClient {
+	t.Helper()
+	client, err := webex.NewClient("test-token", &webexsdk.Config{
+		BaseURL:        srv.BaseURL(),
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestServer_RoomsAndMessages(t *testing.T) {
+	srv := NewServer(nil)
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	me, err := client.People().GetMe()
+	if err != nil {
+		t.Fatalf("GetMe failed: %v", err)
+	}
+	if me.ID != srv.MeID() || me.DisplayName != "Test Bot" {
+		t.Errorf("Unexpected me: %+v", me)
+	}
+
+	room, err := client.Rooms().Create(&rooms.Room{Title: "Standup"})
+	if err != nil {
+		t.Fatalf("Create room failed: %v", err)
+	}
+	if room.ID == "" || room.CreatorID != me.ID || room.Type != "group" {
+		t.Errorf("Unexpected room: %+v", room)
+	}
+
+	for _, text := range []string{"one", "two", "three"} {
+		if _, err := client.Messages().Create(&messages.Message{RoomID: room.ID, Text: text}); err != nil {
+			t.Fatalf("Create message failed: %v", err)
+		}
+	}
+
+	// Pages of two follow the Link header, newest first
+	all, err := client.Messages().ListAll(context.Background(), &messages.ListOptions{RoomID: room.ID, Max: 2}).Collect()
+	if err != nil {
+		t.Fatalf("ListAll failed: %v", err)
+	}
+	if len(all) != 3 || all[0].Text != "three" || all[2].Text != "one" {
+		t.Errorf("Unexpected messages: %+v", all)
+	}
+	if all[0].PersonID != me.ID || all[0].RoomType != "group" {
+		t.Errorf("Expected message authored by me in a group room, got %+v", all[0])
+	}
+
+	page, err := client.Messages().List(&messages.ListOptions{RoomID: room.ID, Max: 2})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if !page.HasNext() || len(page.Items) != 2 {
+		t.Fatalf("Expected a first page of 2 with a next link, got %d items, hasNext=%v", len(page.Items), page.HasNext())
+	}
+	next, err := page.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	if len(next.Items) != 1 || next.Items[0].Text != "one" || next.HasNext() {
+		t.Errorf("Expected a typed last page with one message, got %+v", next.Items)
+	}
+
+	// Uploads are accepted as multipart
+	msg, err := client.Messages().CreateWithAttachment(&messages.Message{RoomID: room.ID, Text: "file"}, &messages.FileUpload{
+		FileName:   "hello.txt",
+		Base64Data: base64.StdEncoding.EncodeToString([]byte("hello")),
+	})
+	if err != nil {
+		t.Fatalf("CreateWithAttachment failed: %v", err)
+	}
+	if len(msg.Files) != 1 || msg.Text != "file" {
+		t.Errorf("Unexpected message with attachment: %+v", msg)
+	}
+
+	if err := client.Rooms().Delete(room.ID); err != nil {
+		t.Fatalf("Delete room failed: %v", err)
+	}
+	if len(srv.Items(webexsdk.ResourceMessages)) != 0 {
+		t.Error("Expected room deletion to remove its messages")
+	}
+}
+
+func TestServer_MembershipsAndErrors(t *testing.T) {
+	srv := NewServer(nil)
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	aliceID := srv.Seed(webexsdk.ResourcePeople, people.Person{
+		Emails:      []string{"alice@example.com"},
+		DisplayName: "Alice",
+	})
+	room, err := client.Rooms().Create(&rooms.Room{Title: "Project"})
+	if err != nil {
+		t.Fatalf("Create room failed: %v", err)
+	}
+
+	m, err := client.Memberships().Create(&memberships.Membership{RoomID: room.ID, PersonEmail: "alice@example.com"})
+	if err != nil {
+		t.Fatalf("Create membership failed: %v", err)
+	}
+	if m.PersonID != aliceID || m.PersonDisplayName != "Alice" {
+		t.Errorf("Expected membership resolved to seeded person, got %+v", m)
+	}
+
+	_, err = client.Memberships().Create(&memberships.Membership{RoomID: room.ID, PersonID: aliceID})
+	if !webexsdk.IsConflict(err) {
+		t.Errorf("Expected ConflictError for duplicate membership, got %v", err)
+	}
+
+	_, err = client.Rooms().Get("missing")
+	var notFound *webexsdk.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected NotFoundError, got %v", err)
+	}
+	if notFound.Message == "" || notFound.TrackingID == "" {
+		t.Errorf("Expected message and tracking ID on error, got %+v", notFound.APIError)
+	}
+
+	list, err := client.Memberships().List(&memberships.ListOptions{RoomID: room.ID})
+	if err != nil {
+		t.Fatalf("List memberships failed: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("Expected creator and Alice as members, got %d", len(list.Items))
+	}
+}
+
+func TestServer_EventsAndAttachmentActions(t *testing.T) {
+	srv := NewServer(nil)
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	room, _ := client.Rooms().Create(&rooms.Room{Title: "Cards"})
+	msg, err := client.Messages().Create(&messages.Message{RoomID: room.ID, Text: "card"})
+	if err != nil {
+		t.Fatalf("Create message failed: %v", err)
+	}
+
+	action, err := client.AttachmentActions().Create(&attachmentactions.AttachmentAction{
+		Type:      "submit",
+		MessageID: msg.ID,
+		Inputs:    map[string]interface{}{"choice": "yes"},
+	})
+	if err != nil {
+		t.Fatalf("Create attachment action failed: %v", err)
+	}
+	got, err := client.AttachmentActions().Get(action.ID)
+	if err != nil || got.RoomID != room.ID || got.Inputs["choice"] != "yes" {
+		t.Errorf("Unexpected attachment action %+v (err %v)", got, err)
+	}
+
+	page, err := client.Events().List(&events.ListOptions{Resource: "messages", Type: "created"})
+	if err != nil {
+		t.Fatalf("List events failed: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Data.ID != msg.ID || page.Items[0].Data.Text != "card" {
+		t.Errorf("Expected a messages/created event for the message, got %+v", page.Items)
+	}
+}
+
+func TestServer_InjectedFaults(t *testing.T) {
+	srv := NewServer(&Config{Token: "test-token"})
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	// One 429 is retried transparently by the client
+	srv.InjectRateLimit(webexsdk.ResourceRooms, 1, 0)
+	if _, err := client.Rooms().Create(&rooms.Room{Title: "Retried"}); err != nil {
+		t.Fatalf("Expected retry after 429 to succeed, got %v", err)
+	}
+	if srv.Requests() != 2 {
+		t.Errorf("Expected 2 requests, got %d", srv.Requests())
+	}
+
+	srv.InjectFault(Fault{Method: "GET", Resource: webexsdk.ResourcePeople, Status: 403, Message: "nope"})
+	_, err := client.People().GetMe()
+	var forbidden *webexsdk.ForbiddenError
+	if !errors.As(err, &forbidden) || forbidden.Message != "nope" {
+		t.Errorf("Expected injected ForbiddenError, got %v", err)
+	}
+
+	other, _ := webex.NewClient("wrong-token", &webexsdk.Config{BaseURL: srv.BaseURL(), MaxRetries: 0})
+	if _, err := other.People().GetMe(); !webexsdk.IsAuthError(err) {
+		t.Errorf("Expected AuthError for wrong token, got %v", err)
+	}
+}
@@ -0,0 +1,362 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header Webex uses to carry the HMAC-SHA1 signature
+	// of the request body, computed with the webhook's secret.
+	SignatureHeader = "X-Spark-Signature"
+
+	// All matches any resource or event when registering an EventHandler.
+	// It mirrors the "all" value accepted by the Webhooks API.
+	All = "all"
+)
+
+// ErrInvalidSignature is returned by VerifyRequest when the signature header
+// is missing or does not match the request body.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrMissingSecret is returned by NewReceiver when ReceiverConfig.Secret is
+// empty and InsecureSkipVerify is not set.
+var ErrMissingSecret = errors.New("webhook secret is required")
+
+// Envelope is the JSON payload Webex POSTs to a webhook's targetUrl.
+type Envelope struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	TargetURL string     `json:"targetUrl,omitempty"`
+	Resource  string     `json:"resource,omitempty"`
+	Event     string     `json:"event,omitempty"`
+	Filter    string     `json:"filter,omitempty"`
+	OrgID     string     `json:"orgId,omitempty"`
+	CreatedBy string     `json:"createdBy,omitempty"`
+	AppID     string     `json:"appId,omitempty"`
+	OwnedBy   string     `json:"ownedBy,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	Created   *time.Time `json:"created,omitempty"`
+	ActorID   string     `json:"actorId,omitempty"`
+	Data      EventData  `json:"data"`
+}
+
+// EventData holds the "data" object of a webhook envelope. The common fields
+// are decoded directly; the full object is kept in Raw so resource-specific
+// fields can be decoded with Decode.
+type EventData struct {
+	ID          string     `json:"id,omitempty"`
+	RoomID      string     `json:"roomId,omitempty"`
+	RoomType    string     `json:"roomType,omitempty"`
+	PersonID    string     `json:"personId,omitempty"`
+	PersonEmail string     `json:"personEmail,omitempty"`
+	MessageID   string     `json:"messageId,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	Created     *time.Time `json:"created,omitempty"`
+
+	// Raw is the undecoded data object.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the common fields and preserves the raw object.
+func (d *EventData) UnmarshalJSON(b []byte) error {
+	type eventData EventData
+	var decoded eventData
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return err
+	}
+	*d = EventData(decoded)
+	d.Raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+// MarshalJSON returns the raw object when present, so an envelope
+// round-trips without losing resource-specific fields.
+func (d EventData) MarshalJSON() ([]byte, error) {
+	if len(d.Raw) > 0 {
+		return d.Raw, nil
+	}
+	type eventData EventData
+	return json.Marshal(eventData(d))
+}
+
+// Decode unmarshals the raw data object into v, for example a
+// messages.Message or memberships.Membership.
+func (d EventData) Decode(v interface{}) error {
+	if len(d.Raw) == 0 {
+		return fmt.Errorf("webhook data is empty")
+	}
+	return json.Unmarshal(d.Raw, v)
+}
+
+// EventHandler is a function that handles a verified webhook notification
+type EventHandler func(envelope *Envelope)
+
+// ReceiverConfig holds the configuration for a webhook Receiver
+type ReceiverConfig struct {
+	// Secret is the webhook secret used to verify the X-Spark-Signature header.
+	// It is required unless InsecureSkipVerify is set.
+	Secret string
+
+	// InsecureSkipVerify allows an empty Secret, in which case signatures
+	// are not checked and anyone who can reach the endpoint can deliver
+	// notifications. Use it only for webhooks created without a secret.
+	InsecureSkipVerify bool
+
+	// ReplayWindow is how long a delivered notification is remembered in
+	// order to reject replays of the same signed body. Default: 5m.
+	// Set to a negative value to disable replay protection.
+	ReplayWindow time.Duration
+
+	// MaxBodyBytes limits the size of an accepted request body. Default: 1 MiB.
+	MaxBodyBytes int64
+}
+
+// DefaultReceiverConfig returns the default configuration for a webhook Receiver
+func DefaultReceiverConfig() *ReceiverConfig {
+	return &ReceiverConfig{
+		ReplayWindow: 5 * time.Minute,
+		MaxBodyBytes: 1 << 20,
+	}
+}
+
+// Receiver is an http.Handler that verifies, decodes and dispatches incoming
+// webhook notifications to handlers registered per resource and event.
+type Receiver struct {
+	config   *ReceiverConfig
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+
+	seenMu sync.Mutex
+	// seen maps body digests to when they were delivered, or to the zero
+	// time while their handlers run.
+	seen map[string]time.Time
+	// delivered lists the delivered digests oldest first, so that expired
+	// entries are pruned from its front.
+	delivered []seenDigest
+}
+
+// seenDigest is an entry of Receiver.delivered.
+type seenDigest struct {
+	key string
+	at  time.Time
+}
+
+// NewReceiver creates a new webhook Receiver.
+// If config is nil, the default configuration will be used. It returns
+// ErrMissingSecret if the configuration has no Secret and does not set
+// InsecureSkipVerify.
+func NewReceiver(config *ReceiverConfig) (*Receiver, error) {
+	defaults := DefaultReceiverConfig()
+	if config == nil {
+		config = defaults
+	} else {
+		if config.ReplayWindow == 0 {
+			config.ReplayWindow = defaults.ReplayWindow
+		}
+		if config.MaxBodyBytes <= 0 {
+			config.MaxBodyBytes = defaults.MaxBodyBytes
+		}
+	}
+	if config.Secret == "" && !config.InsecureSkipVerify {
+		return nil, ErrMissingSecret
+	}
+
+	return &Receiver{
+		config:   config,
+		handlers: make(map[string][]EventHandler),
+		seen:     make(map[string]time.Time),
+	}, nil
+}
+
+// On registers a handler for the given resource and event, for example
+// On("messages", "created", h). Use All for either argument to match
+// every resource or event.
+func (r *Receiver) On(resource, event string, handler EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := handlerKey(resource, event)
+	r.handlers[key] = append(r.handlers[key], handler)
+}
+
+// ServeHTTP implements http.Handler. It responds with 405 for non-POST
+// requests, 401 when the signature does not verify, 409 for a replayed
+// notification, 400 for a malformed body and 200 once the handlers have run.
+// A notification counts as delivered only once its handlers have returned,
+// so one whose handler panicked is accepted again when Webex retries it.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, r.config.MaxBodyBytes))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	signature := req.Header.Get(SignatureHeader)
+	if r.config.Secret != "" && !VerifySignature(r.config.Secret, body, signature) {
+		http.Error(w, ErrInvalidSignature.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		http.Error(w, "error parsing webhook envelope", http.StatusBadRequest)
+		return
+	}
+
+	key, ok := r.claim(body)
+	if !ok {
+		http.Error(w, "duplicate webhook notification", http.StatusConflict)
+		return
+	}
+	delivered := false
+	defer func() { r.release(key, delivered) }()
+
+	r.dispatch(&envelope)
+	delivered = true
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls every handler registered for the envelope's resource and
+// event, including handlers registered with All.
+func (r *Receiver) dispatch(envelope *Envelope) {
+	r.mu.RLock()
+	var handlers []EventHandler
+	for _, key := range []string{
+		handlerKey(envelope.Resource, envelope.Event),
+		handlerKey(envelope.Resource, All),
+		handlerKey(All, envelope.Event),
+		handlerKey(All, All),
+	} {
+		handlers = append(handlers, r.handlers[key]...)
+	}
+	r.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(envelope)
+	}
+}
+
+// claim reports whether body may be dispatched: false if the same body was
+// delivered within the replay window or is being dispatched right now. If
+// it returns true, the caller must call release with the returned key once
+// dispatch is over.
+func (r *Receiver) claim(body []byte) (string, bool) {
+	if r.config.ReplayWindow < 0 {
+		return "", true
+	}
+
+	sum := sha1.Sum(body)
+	key := hex.EncodeToString(sum[:])
+
+	r.seenMu.Lock()
+	defer r.seenMu.Unlock()
+
+	r.pruneLocked(time.Now())
+	if _, ok := r.seen[key]; ok {
+		return "", false
+	}
+	r.seen[key] = time.Time{}
+	return key, true
+}
+
+// release ends the dispatch of the body claimed as key, recording it as
+// delivered if its handlers returned, or forgetting it otherwise.
+func (r *Receiver) release(key string, delivered bool) {
+	if key == "" {
+		return
+	}
+
+	r.seenMu.Lock()
+	defer r.seenMu.Unlock()
+
+	if !delivered {
+		delete(r.seen, key)
+		return
+	}
+	now := time.Now()
+	r.seen[key] = now
+	r.delivered = append(r.delivered, seenDigest{key: key, at: now})
+}
+
+// pruneLocked forgets the digests delivered more than the replay window
+// before now. r.seenMu must be held.
+func (r *Receiver) pruneLocked(now time.Time) {
+	n := 0
+	for _, d := range r.delivered {
+		if now.Sub(d.at) <= r.config.ReplayWindow {
+			break
+		}
+		if r.seen[d.key].Equal(d.at) {
+			delete(r.seen, d.key)
+		}
+		n++
+	}
+	r.delivered = r.delivered[n:]
+}
+
+// handlerKey builds the map key for a resource/event pair.
+func handlerKey(resource, event string) string {
+	return resource + ":" + event
+}
+
+// VerifySignature reports whether signature is the hex-encoded HMAC-SHA1 of
+// body keyed with secret, as sent by Webex in the X-Spark-Signature header.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if signature == "" {
+		return false
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// VerifyRequest reads the request body, verifies its X-Spark-Signature
+// against secret and decodes it into an Envelope. It is useful when a
+// Receiver does not fit the caller's routing, e.g. inside an existing handler.
+func VerifyRequest(req *http.Request, secret string) (*Envelope, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	if !VerifySignature(secret, body, req.Header.Get(SignatureHeader)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("error parsing webhook envelope: %w", err)
+	}
+
+	return &envelope, nil
+}
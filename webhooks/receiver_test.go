@@ -0,0 +1,299 @@
+/* SPDX-License-Identifier: MPL-2.0
+ * Copyright 2025 Tejus Pratap <tejzpr@gmail.com>
+ *
+ * See CONTRIBUTORS.md for full contributor list.
+ */
+
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testEnvelope = `{
+	"id": "webhook-id",
+	"name": "Messages Webhook",
+	"targetUrl": "https://example.com/webhook",
+	"resource": "messages",
+	"event": "created",
+	"orgId": "org-id",
+	"createdBy": "creator-id",
+	"appId": "app-id",
+	"ownedBy": "creator",
+	"status": "active",
+	"actorId": "actor-id",
+	"data": {
+		"id": "message-id",
+		"roomId": "room-id",
+		"roomType": "group",
+		"personId": "person-id",
+		"personEmail": "person@example.com",
+		"created": "2025-01-01T00:00:00.000Z",
+		"files": ["https://example.com/file"]
+	}
+}`
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSignedRequest(secret, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, sign(secret, body))
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(testEnvelope)
+	if !VerifySignature("secret", body, sign("secret", testEnvelope)) {
+		t.Error("Expected valid signature to verify")
+	}
+	if VerifySignature("other", body, sign("secret", testEnvelope)) {
+		t.Error("Expected signature with wrong secret to fail")
+	}
+	if VerifySignature("secret", body, "") {
+		t.Error("Expected empty signature to fail")
+	}
+	if VerifySignature("secret", body, "not-hex") {
+		t.Error("Expected non-hex signature to fail")
+	}
+}
+
+func newTestReceiver(t *testing.T, config *ReceiverConfig) *Receiver {
+	t.Helper()
+	receiver, err := NewReceiver(config)
+	if err != nil {
+		t.Fatalf("NewReceiver failed: %v", err)
+	}
+	return receiver
+}
+
+func TestNewReceiverRequiresSecret(t *testing.T) {
+	for _, config := range []*ReceiverConfig{nil, {}, {ReplayWindow: time.Minute}} {
+		if _, err := NewReceiver(config); !errors.Is(err, ErrMissingSecret) {
+			t.Errorf("Expected ErrMissingSecret for %+v, got %v", config, err)
+		}
+	}
+
+	receiver, err := NewReceiver(&ReceiverConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("Expected InsecureSkipVerify to allow an empty secret, got %v", err)
+	}
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(testEnvelope)))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected an unsigned notification to be accepted, got status %d", rec.Code)
+	}
+}
+
+func TestReceiverDispatch(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{Secret: "secret"})
+
+	var got *Envelope
+	var calls []string
+	receiver.On("messages", "created", func(e *Envelope) {
+		got = e
+		calls = append(calls, "exact")
+	})
+	receiver.On("messages", All, func(e *Envelope) { calls = append(calls, "resource") })
+	receiver.On(All, All, func(e *Envelope) { calls = append(calls, "all") })
+	receiver.On("memberships", "created", func(e *Envelope) { calls = append(calls, "other") })
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, newSignedRequest("secret", testEnvelope))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	if strings.Join(calls, ",") != "exact,resource,all" {
+		t.Errorf("Expected handlers exact,resource,all, got %v", calls)
+	}
+	if got == nil {
+		t.Fatal("Expected envelope to be dispatched")
+	}
+	if got.Resource != "messages" || got.Event != "created" {
+		t.Errorf("Expected messages/created, got %s/%s", got.Resource, got.Event)
+	}
+	if got.ActorID != "actor-id" {
+		t.Errorf("Expected actorId 'actor-id', got '%s'", got.ActorID)
+	}
+	if got.OrgID != "org-id" {
+		t.Errorf("Expected orgId 'org-id', got '%s'", got.OrgID)
+	}
+	if got.Data.ID != "message-id" || got.Data.RoomID != "room-id" {
+		t.Errorf("Expected data id/roomId to be decoded, got %+v", got.Data)
+	}
+	if got.Data.Created == nil {
+		t.Error("Expected data created to be decoded")
+	}
+
+	var extra struct {
+		Files []string `json:"files"`
+	}
+	if err := got.Data.Decode(&extra); err != nil {
+		t.Fatalf("Failed to decode data: %v", err)
+	}
+	if len(extra.Files) != 1 {
+		t.Errorf("Expected 1 file, got %d", len(extra.Files))
+	}
+}
+
+func TestReceiverRejectsBadSignature(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{Secret: "secret"})
+	called := false
+	receiver.On(All, All, func(e *Envelope) { called = true })
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, newSignedRequest("wrong", testEnvelope))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(testEnvelope))
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 for missing signature, got %d", rec.Code)
+	}
+
+	if called {
+		t.Error("Expected handler not to be called")
+	}
+}
+
+func TestReceiverRejectsReplay(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{Secret: "secret"})
+	count := 0
+	receiver.On(All, All, func(e *Envelope) { count++ })
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, newSignedRequest("secret", testEnvelope))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, newSignedRequest("secret", testEnvelope))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected status 409 for replay, got %d", rec.Code)
+	}
+	if count != 1 {
+		t.Errorf("Expected handler to be called once, got %d", count)
+	}
+}
+
+func TestReceiverReplayAfterPanic(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{Secret: "secret"})
+	count := 0
+	receiver.On(All, All, func(e *Envelope) {
+		count++
+		if count == 1 {
+			panic("handler failed")
+		}
+	})
+
+	func() {
+		defer func() { _ = recover() }()
+		receiver.ServeHTTP(httptest.NewRecorder(), newSignedRequest("secret", testEnvelope))
+	}()
+
+	// Webex retries the notification whose handler failed
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, newSignedRequest("secret", testEnvelope))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for the retry, got %d", rec.Code)
+	}
+	if count != 2 {
+		t.Errorf("Expected handler to be called twice, got %d", count)
+	}
+}
+
+func TestReceiverReplayWindowExpires(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{Secret: "secret", ReplayWindow: 10 * time.Millisecond})
+	count := 0
+	receiver.On(All, All, func(e *Envelope) { count++ })
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, newSignedRequest("secret", testEnvelope))
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status 200 after the window, got %d", rec.Code)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if count != 2 {
+		t.Errorf("Expected handler to be called twice, got %d", count)
+	}
+
+	receiver.seenMu.Lock()
+	defer receiver.seenMu.Unlock()
+	if len(receiver.seen) != 1 || len(receiver.delivered) != 1 {
+		t.Errorf("Expected the expired digest to be pruned, got %d seen, %d delivered", len(receiver.seen), len(receiver.delivered))
+	}
+}
+
+func TestReceiverReplayDisabled(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{ReplayWindow: -1, InsecureSkipVerify: true})
+	count := 0
+	receiver.On(All, All, func(e *Envelope) { count++ })
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(testEnvelope))
+		receiver.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", rec.Code)
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected handler to be called twice, got %d", count)
+	}
+}
+
+func TestReceiverBadRequests(t *testing.T) {
+	receiver := newTestReceiver(t, &ReceiverConfig{MaxBodyBytes: 64, InsecureSkipVerify: true})
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("{not json")))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(make([]byte, 128))))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status 413, got %d", rec.Code)
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	envelope, err := VerifyRequest(newSignedRequest("secret", testEnvelope), "secret")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if envelope.Data.PersonEmail != "person@example.com" {
+		t.Errorf("Expected personEmail 'person@example.com', got '%s'", envelope.Data.PersonEmail)
+	}
+
+	_, err = VerifyRequest(newSignedRequest("wrong", testEnvelope), "secret")
+	if err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
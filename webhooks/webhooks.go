@@ -7,8 +7,9 @@
 package webhooks
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -36,10 +37,7 @@ type ListOptions struct {
 }
 
 // WebhooksPage represents a paginated list of webhooks
-type WebhooksPage struct {
-	Items []Webhook `json:"items"`
-	*webexsdk.Page
-}
+type WebhooksPage = webexsdk.TypedPage[Webhook]
 
 // Config holds the configuration for the Webhooks plugin
 type Config struct {
@@ -71,12 +69,17 @@ func New(webexClient *webexsdk.Client, config *Config) *Client {
 
 // List returns a list of webhooks
 func (c *Client) List(options *ListOptions) (*WebhooksPage, error) {
+	return c.ListCtx(context.Background(), options)
+}
+
+// ListCtx is like List but uses ctx for the request.
+func (c *Client) ListCtx(ctx context.Context, options *ListOptions) (*WebhooksPage, error) {
 	params := url.Values{}
 	if options != nil && options.Max > 0 {
 		params.Set("max", fmt.Sprintf("%d", options.Max))
 	}
 
-	resp, err := c.webexClient.Request(http.MethodGet, "webhooks", params, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, "webhooks", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,25 +89,35 @@ func (c *Client) List(options *ListOptions) (*WebhooksPage, error) {
 		return nil, err
 	}
 
-	// Unmarshal items into Webhooks
-	webhooksPage := &WebhooksPage{
-		Page:  page,
-		Items: make([]Webhook, len(page.Items)),
-	}
+	return webexsdk.NewTypedPage[Webhook](page)
+}
 
-	for i, item := range page.Items {
-		var webhook Webhook
-		if err := json.Unmarshal(item, &webhook); err != nil {
-			return nil, err
+// ListAll returns an Iterator over every Webhook matching options, following
+// pagination links across pages. Use Limit on the returned Iterator to cap
+// the number of items.
+func (c *Client) ListAll(ctx context.Context, options *ListOptions) *webexsdk.Iterator[Webhook] {
+	return webexsdk.NewIterator(ctx, func(ctx context.Context) ([]Webhook, *webexsdk.Page, error) {
+		page, err := c.ListCtx(ctx, options)
+		if err != nil {
+			return nil, nil, err
 		}
-		webhooksPage.Items[i] = webhook
-	}
+		return page.Items, page.Page, nil
+	})
+}
 
-	return webhooksPage, nil
+// All returns a range-over-func sequence of every Webhook matching options.
+// Iteration stops at the first error, which is yielded as the final element.
+func (c *Client) All(ctx context.Context, options *ListOptions) iter.Seq2[Webhook, error] {
+	return c.ListAll(ctx, options).All()
 }
 
 // Create creates a new webhook
 func (c *Client) Create(webhook *Webhook) (*Webhook, error) {
+	return c.CreateCtx(context.Background(), webhook)
+}
+
+// CreateCtx is like Create but uses ctx for the request.
+func (c *Client) CreateCtx(ctx context.Context, webhook *Webhook) (*Webhook, error) {
 	if webhook.Name == "" {
 		return nil, fmt.Errorf("webhook name is required")
 	}
@@ -118,7 +131,7 @@ func (c *Client) Create(webhook *Webhook) (*Webhook, error) {
 		return nil, fmt.Errorf("webhook event is required")
 	}
 
-	resp, err := c.webexClient.Request(http.MethodPost, "webhooks", nil, webhook)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPost, "webhooks", nil, webhook)
 	if err != nil {
 		return nil, err
 	}
@@ -133,12 +146,17 @@ func (c *Client) Create(webhook *Webhook) (*Webhook, error) {
 
 // Get returns details for a webhook
 func (c *Client) Get(webhookID string) (*Webhook, error) {
+	return c.GetCtx(context.Background(), webhookID)
+}
+
+// GetCtx is like Get but uses ctx for the request.
+func (c *Client) GetCtx(ctx context.Context, webhookID string) (*Webhook, error) {
 	if webhookID == "" {
 		return nil, fmt.Errorf("webhookID is required")
 	}
 
 	path := fmt.Sprintf("webhooks/%s", webhookID)
-	resp, err := c.webexClient.Request(http.MethodGet, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +171,11 @@ func (c *Client) Get(webhookID string) (*Webhook, error) {
 
 // Update updates an existing webhook
 func (c *Client) Update(webhookID string, webhook *Webhook) (*Webhook, error) {
+	return c.UpdateCtx(context.Background(), webhookID, webhook)
+}
+
+// UpdateCtx is like Update but uses ctx for the request.
+func (c *Client) UpdateCtx(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, error) {
 	if webhookID == "" {
 		return nil, fmt.Errorf("webhookID is required")
 	}
@@ -187,7 +210,7 @@ func (c *Client) Update(webhookID string, webhook *Webhook) (*Webhook, error) {
 	}
 
 	path := fmt.Sprintf("webhooks/%s", webhookID)
-	resp, err := c.webexClient.Request(http.MethodPut, path, nil, updateData)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodPut, path, nil, updateData)
 	if err != nil {
 		return nil, err
 	}
@@ -202,12 +225,17 @@ func (c *Client) Update(webhookID string, webhook *Webhook) (*Webhook, error) {
 
 // Delete deletes a webhook
 func (c *Client) Delete(webhookID string) error {
+	return c.DeleteCtx(context.Background(), webhookID)
+}
+
+// DeleteCtx is like Delete but uses ctx for the request.
+func (c *Client) DeleteCtx(ctx context.Context, webhookID string) error {
 	if webhookID == "" {
 		return fmt.Errorf("webhookID is required")
 	}
 
 	path := fmt.Sprintf("webhooks/%s", webhookID)
-	resp, err := c.webexClient.Request(http.MethodDelete, path, nil, nil)
+	resp, err := c.webexClient.RequestWithRetry(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}